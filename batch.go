@@ -0,0 +1,167 @@
+package yts
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultBatchConcurrency is the number of concurrent requests made by the
+// MovieDetailsBatch method when the Concurrency of its MovieDetailsBatchConfig
+// is zero.
+const DefaultBatchConcurrency = 4
+
+// A MovieDetailsBatchConfig allows you to configure the behavior of the
+// MovieDetailsBatch method, a nil or zero value MovieDetailsBatchConfig makes
+// DefaultBatchConcurrency concurrent requests.
+type MovieDetailsBatchConfig struct {
+	// The maximum number of concurrent requests, the requests made remain subject
+	// to the RateLimit of the client, zero meaning DefaultBatchConcurrency.
+	Concurrency int
+
+	// This callback is invoked with each MovieDetailsBatchResult as soon as the
+	// request for it completes, invocations are never concurrent with each other.
+	OnResult func(MovieDetailsBatchResult)
+}
+
+func (cfg *MovieDetailsBatchConfig) validate() error {
+	if cfg.Concurrency < 0 {
+		return fmt.Errorf("provided concurrency cannot be negative")
+	}
+	return nil
+}
+
+// A MovieDetailsBatchResult holds the outcome of fetching the details of a single
+// movie with the MovieDetailsBatch method, exactly one of Response and Err is set.
+type MovieDetailsBatchResult struct {
+	MovieID  int
+	Response *MovieDetailsResponse
+	Err      error
+}
+
+// uniqueValues returns the provided values without duplicates in their original
+// order.
+func uniqueValues[T comparable](values []T) []T {
+	var (
+		seen   = make(map[T]struct{}, len(values))
+		unique = make([]T, 0, len(values))
+	)
+
+	for _, value := range values {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		unique = append(unique, value)
+	}
+	return unique
+}
+
+// runBatch calls the provided job with each of the provided keys, running at most
+// concurrency jobs at once, and calls onResult with the outcome of each job as soon
+// as it completes, invocations of onResult are never concurrent with each other.
+// Once ctx is done the jobs not yet started fail with the error of the context.
+func runBatch[K comparable, V any](
+	ctx context.Context,
+	keys []K,
+	concurrency int,
+	job func(ctx context.Context, key K) (V, error),
+	onResult func(key K, value V, err error),
+) {
+	type result struct {
+		key   K
+		value V
+		err   error
+	}
+
+	var (
+		jobs    = make(chan K)
+		results = make(chan result)
+		wg      sync.WaitGroup
+	)
+
+	for i := 0; i < min(concurrency, len(keys)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range jobs {
+				r := result{key: key}
+				if r.err = ctx.Err(); r.err == nil {
+					r.value, r.err = job(ctx, key)
+				}
+				results <- r
+			}
+		}()
+	}
+
+	go func() {
+		for _, key := range keys {
+			jobs <- key
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	for r := range results {
+		onResult(r.key, r.value, r.err)
+	}
+}
+
+// MovieDetailsBatchWithContext is the same as the MovieDetailsBatch method but
+// requires a context.Context argument to be passed, this context is then passed to
+// the http.NewRequestWithContext calls used for making the network requests, once
+// it is done the movies not yet requested fail with the error of the context.
+func (c *Client) MovieDetailsBatchWithContext(
+	ctx context.Context, movieIDs []int, filters *MovieDetailsFilters, config *MovieDetailsBatchConfig,
+) (map[int]MovieDetailsBatchResult, error) {
+	if filters == nil {
+		err := fmt.Errorf("provided filters cannot be nil")
+		return nil, wrapErr(ErrFilterValidationFailure, err)
+	}
+
+	var batchConfig MovieDetailsBatchConfig
+	if config != nil {
+		batchConfig = *config
+	}
+
+	if err := batchConfig.validate(); err != nil {
+		return nil, wrapErr(ErrValidationFailure, err)
+	}
+
+	concurrency := batchConfig.Concurrency
+	if concurrency == 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	var (
+		ids   = uniqueValues(movieIDs)
+		batch = make(map[int]MovieDetailsBatchResult, len(ids))
+	)
+
+	fetchDetails := func(ctx context.Context, movieID int) (*MovieDetailsResponse, error) {
+		return c.MovieDetailsWithContext(ctx, movieID, filters)
+	}
+
+	runBatch(ctx, ids, concurrency, fetchDetails, func(movieID int, response *MovieDetailsResponse, err error) {
+		result := MovieDetailsBatchResult{MovieID: movieID, Response: response, Err: err}
+		batch[movieID] = result
+		if batchConfig.OnResult != nil {
+			batchConfig.OnResult(result)
+		}
+	})
+
+	return batch, nil
+}
+
+// MovieDetailsBatch method fetches the details of every movie with the provided
+// IDs, see the MovieDetails method, making the requests concurrently. Duplicate IDs
+// are requested only once and the failure for one movie does not affect the
+// others, the returned map holding a MovieDetailsBatchResult for every ID. The
+// provided filters are validated before any request is made and an error is
+// returned in the event validation fails.
+func (c *Client) MovieDetailsBatch(
+	movieIDs []int, filters *MovieDetailsFilters, config *MovieDetailsBatchConfig,
+) (map[int]MovieDetailsBatchResult, error) {
+	return c.MovieDetailsBatchWithContext(context.Background(), movieIDs, filters, config)
+}
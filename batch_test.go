@@ -0,0 +1,186 @@
+package yts_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+const batchMissingMovieID = 404
+
+// movieDetailsBatchHandlerConfigs serves the movie details of the movies with IDs
+// 1 through 6, responds with movie not found for any other movie ID, and counts
+// the requests made as well as the maximum number of requests served concurrently.
+func movieDetailsBatchHandlerConfigs(t *testing.T, counter *testRequestCounter) []testHTTPHandlerConfig {
+	t.Helper()
+	const (
+		testdataDir   = "movie_details_batch"
+		pattern       = "api/v2/movie_details.json"
+		responseDelay = 20 * time.Millisecond
+	)
+
+	var configs []testHTTPHandlerConfig
+	for movieID := 1; movieID <= 6; movieID++ {
+		handlerCfg := defaultHandlerConfig(t, pattern, testdataDir, fmt.Sprintf("movie_%d.json", movieID))
+		handlerCfg = handlerCfg.withQuery(fmt.Sprintf("movie_id=%d", movieID))
+		configs = append(configs, handlerCfg.withDelay(responseDelay).withCounter(counter))
+	}
+
+	notFoundCfg := defaultHandlerConfig(t, pattern, "movie_details", "movie_not_found.json")
+	return append(configs, notFoundCfg.withDelay(responseDelay).withCounter(counter))
+}
+
+func TestClient_MovieDetailsBatchWithContext(t *testing.T) {
+	const methodName = "Client.MovieDetailsBatch"
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		movieIDs        []int
+		filters         *yts.MovieDetailsFilters
+		config          *yts.MovieDetailsBatchConfig
+		wantResults     map[int]error
+		wantRequests    int32
+		wantMaxInFlight int32
+		wantErr         error
+	}{
+		{
+			name:     "returns error when filters are nil",
+			ctx:      context.Background(),
+			movieIDs: []int{1, 2},
+			filters:  nil,
+			config:   nil,
+			wantErr:  yts.ErrFilterValidationFailure,
+		},
+		{
+			name:     "returns error when concurrency is negative",
+			ctx:      context.Background(),
+			movieIDs: []int{1},
+			filters:  yts.DefaultMovieDetailsFilters(),
+			config:   &yts.MovieDetailsBatchConfig{Concurrency: -1},
+			wantErr:  yts.ErrValidationFailure,
+		},
+		{
+			name:         "returns empty results when no movie IDs are provided",
+			ctx:          context.Background(),
+			movieIDs:     nil,
+			filters:      yts.DefaultMovieDetailsFilters(),
+			config:       nil,
+			wantResults:  map[int]error{},
+			wantRequests: 0,
+		},
+		{
+			name:         "requests duplicate movie IDs only once",
+			ctx:          context.Background(),
+			movieIDs:     []int{1, 2, 2, 3, 1},
+			filters:      yts.DefaultMovieDetailsFilters(),
+			config:       nil,
+			wantResults:  map[int]error{1: nil, 2: nil, 3: nil},
+			wantRequests: 3,
+		},
+		{
+			name:     "returns error per movie ID without failing the batch",
+			ctx:      context.Background(),
+			movieIDs: []int{1, batchMissingMovieID, -1},
+			filters:  yts.DefaultMovieDetailsFilters(),
+			config:   nil,
+			wantResults: map[int]error{
+				1:                   nil,
+				batchMissingMovieID: yts.ErrMovieNotFound,
+				-1:                  yts.ErrValidationFailure,
+			},
+			wantRequests: 2,
+		},
+		{
+			name:     "makes no more concurrent requests than the concurrency",
+			ctx:      context.Background(),
+			movieIDs: []int{1, 2, 3, 4, 5, 6},
+			filters:  yts.DefaultMovieDetailsFilters(),
+			config:   &yts.MovieDetailsBatchConfig{Concurrency: 2},
+			wantResults: map[int]error{
+				1: nil, 2: nil, 3: nil, 4: nil, 5: nil, 6: nil,
+			},
+			wantRequests:    6,
+			wantMaxInFlight: 2,
+		},
+		{
+			name:         "returns context error per movie ID when context is canceled",
+			ctx:          canceledCtx,
+			movieIDs:     []int{1, 2},
+			filters:      yts.DefaultMovieDetailsFilters(),
+			config:       nil,
+			wantResults:  map[int]error{1: context.Canceled, 2: context.Canceled},
+			wantRequests: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := &testRequestCounter{}
+			server := createTestServer(t, movieDetailsBatchHandlerConfigs(t, counter)...)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.APIBaseURL = *serverURL.JoinPath("api", "v2")
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+
+			got, err := c.MovieDetailsBatchWithContext(tt.ctx, tt.movieIDs, tt.filters, tt.config)
+			assertError(t, methodName, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+
+			assertEqual(t, methodName, len(got), len(tt.wantResults))
+			for movieID, wantErr := range tt.wantResults {
+				result := got[movieID]
+				assertEqual(t, methodName, result.MovieID, movieID)
+				assertError(t, methodName, result.Err, wantErr)
+				if wantErr == nil {
+					assertEqual(t, methodName, result.Response.Data.Movie.ID, movieID)
+				}
+			}
+
+			assertEqual(t, methodName, counter.requests.Load(), tt.wantRequests)
+			if tt.wantMaxInFlight > 0 {
+				assertEqual(t, methodName, counter.maxInFlight.Load(), tt.wantMaxInFlight)
+			}
+		})
+	}
+}
+
+func TestClient_MovieDetailsBatchOnResult(t *testing.T) {
+	const methodName = "Client.MovieDetailsBatch"
+
+	server := createTestServer(t, movieDetailsBatchHandlerConfigs(t, &testRequestCounter{})...)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.APIBaseURL = *serverURL.JoinPath("api", "v2")
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+
+	streamed := make(map[int]error)
+	config := &yts.MovieDetailsBatchConfig{
+		Concurrency: 2,
+		OnResult: func(result yts.MovieDetailsBatchResult) {
+			streamed[result.MovieID] = result.Err
+		},
+	}
+
+	got, err := c.MovieDetailsBatch(
+		[]int{1, 2, batchMissingMovieID}, yts.DefaultMovieDetailsFilters(), config,
+	)
+	assertError(t, methodName, err, nil)
+	assertEqual(t, methodName, len(streamed), len(got))
+	for movieID, result := range got {
+		assertEqual(t, methodName, streamed[movieID], result.Err)
+	}
+}
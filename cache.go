@@ -0,0 +1,584 @@
+package yts
+
+import (
+	"container/list"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// An Endpoint identifies the YTS API endpoint or YTS website page fetched by a
+// `yts.Client` method, it is used for configuring per endpoint cache TTLs.
+type Endpoint string
+
+const (
+	EndpointSearchMovies     Endpoint = "search_movies"
+	EndpointMovieDetails     Endpoint = "movie_details"
+	EndpointMovieSuggestions Endpoint = "movie_suggestions"
+	EndpointTrendingMovies   Endpoint = "trending_movies"
+	EndpointHomePageContent  Endpoint = "home_page_content"
+	EndpointMoviePage        Endpoint = "movie_page"
+	EndpointMovieComments    Endpoint = "movie_comments"
+	EndpointBrowseMovies     Endpoint = "browse_movies"
+	EndpointQuickSearch      Endpoint = "quick_search"
+	EndpointMovieReviews     Endpoint = "movie_reviews"
+)
+
+// A CacheEntry represents a response body stored in a Cache alongside the headers
+// of the response and the time at which it was stored.
+type CacheEntry struct {
+	Body     []byte      `json:"body"`
+	Header   http.Header `json:"header"`
+	StoredAt time.Time   `json:"stored_at"`
+}
+
+// A Cache is used by a `yts.Client` for storing response bodies keyed by the URL
+// of the corresponding request, the MemoryCache type is the implementation used
+// by default, you can provide your own implementation for persisting responses to
+// disk or an external store. Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the entry stored for the provided key, and false in the event no
+	// such entry exists.
+	Get(ctx context.Context, key string) (*CacheEntry, bool)
+
+	// Set stores the provided entry for the provided key replacing any existing
+	// entry for the same key.
+	Set(ctx context.Context, key string, entry *CacheEntry)
+
+	// Delete removes the entry stored for the provided key if any.
+	Delete(ctx context.Context, key string)
+}
+
+// A CacheConfig allows you to configure the response caching behavior of a
+// `yts.Client`, a zero value CacheConfig disables caching.
+type CacheConfig struct {
+	// This flag enables response caching for the endpoints which have a positive
+	// TTL provided in the TTLs field.
+	Enabled bool
+
+	// The Cache used for storing responses, when nil a MemoryCache with capacity
+	// Capacity is used.
+	Store Cache
+
+	// The maximum number of entries held by the default MemoryCache, this is
+	// ignored when a Store has been provided.
+	Capacity int
+
+	// The duration for which a cached response is considered fresh for each of the
+	// endpoints, responses for endpoints without a TTL are never cached.
+	TTLs map[Endpoint]time.Duration
+
+	// The duration past its TTL during which a stale response is still returned,
+	// while the response is refreshed in the background.
+	StaleWhileRevalidate time.Duration
+}
+
+// DefaultCacheTTLs returns the per endpoint TTLs used by the CacheConfig instance
+// returned by the DefaultCacheConfig() function.
+func DefaultCacheTTLs() map[Endpoint]time.Duration {
+	return map[Endpoint]time.Duration{
+		EndpointSearchMovies:     10 * time.Minute,
+		EndpointMovieDetails:     6 * time.Hour,
+		EndpointMovieSuggestions: 6 * time.Hour,
+		EndpointTrendingMovies:   5 * time.Minute,
+		EndpointHomePageContent:  15 * time.Minute,
+		EndpointMoviePage:        time.Hour,
+		EndpointMovieComments:    5 * time.Minute,
+		EndpointBrowseMovies:     10 * time.Minute,
+		EndpointQuickSearch:      10 * time.Minute,
+		EndpointMovieReviews:     time.Hour,
+	}
+}
+
+// DefaultCacheConfig returns a `CacheConfig` instance which enables caching with
+// an in-memory LRU cache and sensible per endpoint TTLs, note that caching is
+// disabled for the ClientConfig returned by the DefaultClientConfig() function.
+func DefaultCacheConfig() CacheConfig {
+	const (
+		defaultCapacity             = 256
+		defaultStaleWhileRevalidate = time.Minute
+	)
+
+	return CacheConfig{
+		Enabled:              true,
+		Capacity:             defaultCapacity,
+		TTLs:                 DefaultCacheTTLs(),
+		StaleWhileRevalidate: defaultStaleWhileRevalidate,
+	}
+}
+
+func (cc *CacheConfig) validate() error {
+	return validation.ValidateStruct(
+		cc,
+		validation.Field(
+			&cc.Capacity,
+			validation.When(
+				cc.Enabled && cc.Store == nil,
+				validation.Required,
+			),
+			validation.Min(0),
+		),
+		validation.Field(
+			&cc.TTLs,
+			validation.Each(validation.Min(time.Duration(0))),
+		),
+		validation.Field(
+			&cc.StaleWhileRevalidate,
+			validation.Min(time.Duration(0)),
+		),
+	)
+}
+
+// A CacheMode controls how a single `yts.Client` method call interacts with the
+// response cache, see the WithCacheMode function.
+type CacheMode int
+
+const (
+	// CacheModeDefault returns fresh cached responses and stores new responses.
+	CacheModeDefault CacheMode = iota
+
+	// CacheModeBypass neither reads from nor writes to the response cache.
+	CacheModeBypass
+
+	// CacheModeRefresh ignores cached responses but stores new responses, hence
+	// invalidating the cached responses for the call in question.
+	CacheModeRefresh
+)
+
+type cacheModeKey struct{}
+
+// WithCacheMode returns a copy of the provided context which causes `yts.Client`
+// methods called with it to use the provided CacheMode.
+func WithCacheMode(ctx context.Context, mode CacheMode) context.Context {
+	return context.WithValue(ctx, cacheModeKey{}, mode)
+}
+
+func cacheModeFrom(ctx context.Context) CacheMode {
+	mode, _ := ctx.Value(cacheModeKey{}).(CacheMode)
+	return mode
+}
+
+// A MemoryCache is an in-memory least recently used (LRU) Cache implementation
+// holding a bounded number of entries.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCache returns a new *MemoryCache instance which holds at most the
+// provided number of entries.
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: max(capacity, 1),
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// Get returns the entry stored for the provided key, marking it as the most
+// recently used entry.
+func (mc *MemoryCache) Get(_ context.Context, key string) (*CacheEntry, bool) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	element, ok := mc.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	mc.order.MoveToFront(element)
+	item, _ := element.Value.(*memoryCacheItem)
+	return item.entry, true
+}
+
+// Set stores the provided entry for the provided key, evicting the least recently
+// used entry if the cache is at capacity.
+func (mc *MemoryCache) Set(_ context.Context, key string, entry *CacheEntry) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	if element, ok := mc.entries[key]; ok {
+		item, _ := element.Value.(*memoryCacheItem)
+		item.entry = entry
+		mc.order.MoveToFront(element)
+		return
+	}
+
+	element := mc.order.PushFront(&memoryCacheItem{key, entry})
+	mc.entries[key] = element
+	if mc.order.Len() > mc.capacity {
+		oldest := mc.order.Back()
+		item, _ := mc.order.Remove(oldest).(*memoryCacheItem)
+		delete(mc.entries, item.key)
+	}
+}
+
+// Delete removes the entry stored for the provided key if any.
+func (mc *MemoryCache) Delete(_ context.Context, key string) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	if element, ok := mc.entries[key]; ok {
+		mc.order.Remove(element)
+		delete(mc.entries, key)
+	}
+}
+
+// Len returns the number of entries currently held by the cache.
+func (mc *MemoryCache) Len() int {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	return mc.order.Len()
+}
+
+// Purge removes all entries held by the cache.
+func (mc *MemoryCache) Purge() {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	mc.order.Init()
+	mc.entries = make(map[string]*list.Element)
+}
+
+// A ResponseMetadata instance describes how the response for a single request
+// made by a `yts.Client` method was obtained, see the WithResponseMetadata
+// function for retrieving these.
+type ResponseMetadata struct {
+	// The endpoint the request was made for.
+	Endpoint Endpoint `json:"endpoint"`
+
+	// The URL of the request.
+	URL string `json:"url"`
+
+	// The status code of the network response, this is 0 if the response was served
+	// from the cache without making a network request.
+	StatusCode int `json:"status_code"`
+
+	// The base URL of the mirror which served the network response, this is empty
+	// if the response was served from the cache without making a network request.
+	Mirror string `json:"mirror"`
+
+	// The number of attempts made for the network request, including retries.
+	Attempts int `json:"attempts"`
+
+	// This flag is set when the response body was served from the cache.
+	FromCache bool `json:"from_cache"`
+
+	// This flag is set when a cached response body was served after its validity
+	// was confirmed by the server by means of a conditional request.
+	Revalidated bool `json:"revalidated"`
+
+	// This flag is set when a stale cached response body was served while it is
+	// being refreshed in the background.
+	Stale bool `json:"stale"`
+
+	// The time elapsed since the served response body was fetched or last validated
+	// by the server.
+	Age time.Duration `json:"age"`
+
+	// The time at which the served response body was generated by the server as
+	// reported by its "Date" header, this is zero if the header is missing.
+	Date time.Time `json:"date"`
+}
+
+type responseMetadataKey struct{}
+
+// WithResponseMetadata returns a copy of the provided context which causes the
+// provided function to be called with the ResponseMetadata of every successful
+// request made by `yts.Client` methods called with it, methods which make several
+// requests invoke the provided function once per request.
+func WithResponseMetadata(ctx context.Context, fn func(ResponseMetadata)) context.Context {
+	return context.WithValue(ctx, responseMetadataKey{}, fn)
+}
+
+func reportResponseMetadata(ctx context.Context, metadata *ResponseMetadata) {
+	if fn, ok := ctx.Value(responseMetadataKey{}).(func(ResponseMetadata)); ok {
+		fn(*metadata)
+	}
+}
+
+type responseCache struct {
+	config       CacheConfig
+	store        Cache
+	conditional  bool
+	mu           sync.Mutex
+	revalidating map[string]bool
+}
+
+func newResponseCache(config *ClientConfig) *responseCache {
+	const defaultValidatorCapacity = 128
+	if !config.Cache.Enabled && !config.ConditionalRequests {
+		return nil
+	}
+
+	store := config.Cache.Store
+	switch {
+	case store != nil:
+	case config.Cache.Enabled:
+		store = NewMemoryCache(config.Cache.Capacity)
+	default:
+		store = NewMemoryCache(defaultValidatorCapacity)
+	}
+
+	return &responseCache{
+		config:       config.Cache,
+		store:        store,
+		conditional:  config.ConditionalRequests,
+		revalidating: make(map[string]bool),
+	}
+}
+
+func (rc *responseCache) ttlFor(endpoint Endpoint) time.Duration {
+	if !rc.config.Enabled {
+		return 0
+	}
+	return rc.config.TTLs[endpoint]
+}
+
+func (rc *responseCache) shouldStore(endpoint Endpoint, header http.Header) bool {
+	hasValidators := header.Get("ETag") != "" || header.Get("Last-Modified") != ""
+	return rc.ttlFor(endpoint) > 0 || (rc.conditional && hasValidators)
+}
+
+func (rc *responseCache) conditionalHeader(entry *CacheEntry) http.Header {
+	if !rc.conditional || entry == nil {
+		return nil
+	}
+
+	header := http.Header{}
+	if etag := entry.Header.Get("ETag"); etag != "" {
+		header.Set("If-None-Match", etag)
+	}
+	if lastModified := entry.Header.Get("Last-Modified"); lastModified != "" {
+		header.Set("If-Modified-Since", lastModified)
+	}
+
+	if len(header) == 0 {
+		return nil
+	}
+	return header
+}
+
+// beginRevalidation returns true in the event that no revalidation is already in
+// progress for the provided key, the caller must then call endRevalidation.
+func (rc *responseCache) beginRevalidation(key string) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.revalidating[key] {
+		return false
+	}
+
+	rc.revalidating[key] = true
+	return true
+}
+
+func (rc *responseCache) endRevalidation(key string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	delete(rc.revalidating, key)
+}
+
+// A responseValidator checks the provided response body, generated by the server
+// at the provided date, before a background revalidation stores it in the cache,
+// it must hence not retain any state.
+type responseValidator func(body []byte, date time.Time) error
+
+// fetchDecodedWithContext fetches the provided URL and decodes the response using
+// the provided func, which is passed the response body and the time at which it
+// was generated by the server, see ResponseMetadata.Date. The response is only
+// stored in the cache once decoded without error, so that error payloads and pages
+// which fail to scrape are never served from it.
+func fetchDecodedWithContext[T any](
+	ctx context.Context, c *Client, endpoint Endpoint, targetURL *url.URL,
+	decode func(body []byte, date time.Time) (T, error),
+) (T, error) {
+	validate := func(body []byte, date time.Time) error {
+		_, err := decode(body, date)
+		return err
+	}
+
+	var zero T
+	body, metadata, newEntry, err := c.fetchWithMetadataContext(ctx, endpoint, targetURL, validate)
+	if err != nil {
+		return zero, err
+	}
+
+	value, err := decode(body, metadata.Date)
+	if err != nil {
+		return zero, err
+	}
+
+	if newEntry != nil {
+		c.cache.store.Set(ctx, targetURL.String(), newEntry)
+	}
+	return value, nil
+}
+
+// fetchWithMetadataContext fetches the provided URL through the cache and returns
+// the response body along with the ResponseMetadata of the request, as well as the
+// CacheEntry to store for the response if any, which is left to the caller.
+func (c *Client) fetchWithMetadataContext(
+	ctx context.Context, endpoint Endpoint, targetURL *url.URL, validate responseValidator,
+) ([]byte, *ResponseMetadata, *CacheEntry, error) {
+	metadata := &ResponseMetadata{
+		Endpoint: endpoint,
+		URL:      targetURL.String(),
+	}
+
+	start := time.Now()
+	body, newEntry, err := c.fetchCachedWithContext(ctx, endpoint, targetURL, validate, metadata)
+	if err != nil {
+		c.logger.WarnContext(
+			ctx,
+			"request failed",
+			slog.String("url", metadata.URL),
+			slog.String("endpoint", string(endpoint)),
+			slog.Int("attempts", metadata.Attempts),
+			slog.Duration("duration", time.Since(start)),
+			slog.Any("error", err),
+		)
+		return nil, nil, nil, err
+	}
+
+	c.logger.DebugContext(
+		ctx,
+		"request completed",
+		slog.String("url", metadata.URL),
+		slog.String("endpoint", string(endpoint)),
+		slog.Int("status", metadata.StatusCode),
+		slog.Duration("duration", time.Since(start)),
+		slog.Int("bytes", len(body)),
+		slog.Int("attempts", metadata.Attempts),
+		slog.String("mirror", metadata.Mirror),
+		slog.Bool("from_cache", metadata.FromCache),
+		slog.Bool("revalidated", metadata.Revalidated),
+	)
+
+	reportResponseMetadata(ctx, metadata)
+	return body, metadata, newEntry, nil
+}
+
+func responseDate(header http.Header) time.Time {
+	date, err := http.ParseTime(header.Get("Date"))
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
+
+func (c *Client) fetchCachedWithContext(
+	ctx context.Context, endpoint Endpoint, targetURL *url.URL, validate responseValidator,
+	metadata *ResponseMetadata,
+) ([]byte, *CacheEntry, error) {
+	mode := cacheModeFrom(ctx)
+	if c.cache == nil || mode == CacheModeBypass {
+		body, header, err := c.fetchBodyWithContext(ctx, targetURL, nil, metadata)
+		metadata.Date = responseDate(header)
+		return body, nil, err
+	}
+
+	var (
+		key        = targetURL.String()
+		ttl        = c.cache.ttlFor(endpoint)
+		entry, hit = c.cache.store.Get(ctx, key)
+	)
+
+	if !hit {
+		entry = nil
+	}
+
+	if hit && mode == CacheModeDefault && ttl > 0 {
+		age := time.Since(entry.StoredAt)
+		if age < ttl {
+			metadata.FromCache = true
+			metadata.Age = age
+			metadata.Date = responseDate(entry.Header)
+			return entry.Body, nil, nil
+		}
+
+		if age < ttl+c.cache.config.StaleWhileRevalidate {
+			c.revalidateInBackground(ctx, endpoint, targetURL, entry, validate)
+			metadata.FromCache = true
+			metadata.Stale = true
+			metadata.Age = age
+			metadata.Date = responseDate(entry.Header)
+			return entry.Body, nil, nil
+		}
+	}
+
+	return c.fetchEntryWithContext(ctx, endpoint, targetURL, entry, metadata)
+}
+
+// fetchEntryWithContext fetches the provided URL, revalidating the provided entry
+// if any, and returns the CacheEntry to store for the response if it is cacheable.
+func (c *Client) fetchEntryWithContext(
+	ctx context.Context, endpoint Endpoint, targetURL *url.URL, entry *CacheEntry, metadata *ResponseMetadata,
+) ([]byte, *CacheEntry, error) {
+	header := c.cache.conditionalHeader(entry)
+	body, responseHeader, err := c.fetchBodyWithContext(ctx, targetURL, header, metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if metadata.StatusCode == http.StatusNotModified {
+		body = entry.Body
+		responseHeader = entry.Header
+		metadata.FromCache = true
+		metadata.Revalidated = true
+	}
+
+	metadata.Date = responseDate(responseHeader)
+	if !c.cache.shouldStore(endpoint, responseHeader) {
+		return body, nil, nil
+	}
+
+	newEntry := &CacheEntry{
+		Body:     body,
+		Header:   responseHeader,
+		StoredAt: time.Now(),
+	}
+	return body, newEntry, nil
+}
+
+func (c *Client) revalidateInBackground(
+	ctx context.Context, endpoint Endpoint, targetURL *url.URL, entry *CacheEntry, validate responseValidator,
+) {
+	key := targetURL.String()
+	if !c.cache.beginRevalidation(key) {
+		return
+	}
+
+	go func() {
+		defer c.cache.endRevalidation(key)
+		var (
+			bgCtx    = context.WithoutCancel(ctx)
+			metadata = &ResponseMetadata{Endpoint: endpoint, URL: key}
+		)
+
+		body, newEntry, err := c.fetchEntryWithContext(bgCtx, endpoint, targetURL, entry, metadata)
+		if err == nil {
+			err = validate(body, metadata.Date)
+		}
+
+		if err != nil {
+			c.logger.Warn(
+				"failed to revalidate cached response",
+				slog.String("url", key),
+				slog.Any("error", err),
+			)
+			return
+		}
+
+		if newEntry != nil {
+			c.cache.store.Set(bgCtx, key, newEntry)
+		}
+	}()
+}
@@ -0,0 +1,367 @@
+package yts_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func TestClient_Cache(t *testing.T) {
+	const (
+		methodName  = "Client.MovieSuggestions"
+		testdataDir = "movie_suggestions"
+		movieID     = 57427
+	)
+
+	tests := []struct {
+		name         string
+		cacheCfg     yts.CacheConfig
+		mode         yts.CacheMode
+		wantRequests int32
+	}{
+		{
+			name:         "makes a request for every call when caching is disabled",
+			cacheCfg:     yts.CacheConfig{},
+			wantRequests: 2,
+		},
+		{
+			name:         "returns cached response when caching is enabled",
+			cacheCfg:     yts.DefaultCacheConfig(),
+			wantRequests: 1,
+		},
+		{
+			name:         "makes a request for every call when cache is bypassed",
+			cacheCfg:     yts.DefaultCacheConfig(),
+			mode:         yts.CacheModeBypass,
+			wantRequests: 2,
+		},
+		{
+			name:         "makes a request for every call when cache is refreshed",
+			cacheCfg:     yts.DefaultCacheConfig(),
+			mode:         yts.CacheModeRefresh,
+			wantRequests: 2,
+		},
+		{
+			name: "makes a request for every call when endpoint has no TTL",
+			cacheCfg: yts.CacheConfig{
+				Enabled:  true,
+				Capacity: 1,
+				TTLs:     map[yts.Endpoint]time.Duration{yts.EndpointMovieDetails: time.Hour},
+			},
+			wantRequests: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := &testRequestCounter{}
+			handlerCfg := defaultHandlerConfig(t, "/", testdataDir, "ok_response.json")
+			server := createTestServer(t, handlerCfg.withCounter(counter))
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.APIBaseURL = *serverURL
+			clientCfg.Cache = tt.cacheCfg
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			ctx := yts.WithCacheMode(context.Background(), tt.mode)
+			first, err := c.MovieSuggestionsWithContext(ctx, movieID)
+			assertError(t, methodName, err, nil)
+
+			second, err := c.MovieSuggestionsWithContext(ctx, movieID)
+			assertError(t, methodName, err, nil)
+			assertEqual(t, methodName, second, first)
+			assertEqual(t, methodName, counter.requests.Load(), tt.wantRequests)
+		})
+	}
+}
+
+func TestClient_CacheStaleWhileRevalidate(t *testing.T) {
+	const (
+		methodName  = "Client.TrendingMovies"
+		testdataDir = "trending_movies"
+	)
+
+	counter := &testRequestCounter{}
+	handlerCfg := defaultHandlerConfig(t, "/", testdataDir, "ok_response.html")
+	server := createTestServer(t, handlerCfg.withCounter(counter))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.SiteURL = *serverURL
+	clientCfg.Cache = yts.CacheConfig{
+		Enabled:              true,
+		Capacity:             1,
+		TTLs:                 map[yts.Endpoint]time.Duration{yts.EndpointTrendingMovies: time.Nanosecond},
+		StaleWhileRevalidate: time.Hour,
+	}
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	first, err := c.TrendingMoviesWithContext(context.Background())
+	assertError(t, methodName, err, nil)
+
+	second, err := c.TrendingMoviesWithContext(context.Background())
+	assertError(t, methodName, err, nil)
+	assertEqual(t, methodName, second, first)
+
+	deadline := time.Now().Add(time.Second)
+	for counter.requests.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	assertEqual(t, methodName, counter.requests.Load(), int32(2))
+}
+
+func TestClient_CacheErrorPayloads(t *testing.T) {
+	const methodName = "Client.Cache"
+
+	tests := []struct {
+		name       string
+		handlerCfg testHTTPHandlerConfig
+		call       func(c *yts.Client) error
+		wantErr    error
+	}{
+		{
+			name:       "does not cache API error payloads",
+			handlerCfg: defaultHandlerConfig(t, "/", "search_movies", "status_error.json"),
+			call: func(c *yts.Client) error {
+				_, err := c.SearchMovies(yts.DefaultSearchMoviesFilters("oppenheimer"))
+				return err
+			},
+			wantErr: &yts.APIError{Status: "error", StatusMessage: "Invalid query parameters"},
+		},
+		{
+			name:       "does not cache movie not found payloads",
+			handlerCfg: defaultHandlerConfig(t, "/", "movie_details", "movie_not_found.json"),
+			call: func(c *yts.Client) error {
+				_, err := c.MovieDetails(57427, yts.DefaultMovieDetailsFilters())
+				return err
+			},
+			wantErr: yts.ErrMovieNotFound,
+		},
+		{
+			name:       "does not cache pages which fail to scrape",
+			handlerCfg: defaultHandlerConfig(t, "/", "trending_movies", "missing_selector.html"),
+			call: func(c *yts.Client) error {
+				_, err := c.TrendingMovies()
+				return err
+			},
+			wantErr: yts.ErrContentRetrievalFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := &testRequestCounter{}
+			server := createTestServer(t, tt.handlerCfg.withCounter(counter))
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.APIBaseURL = *serverURL
+			clientCfg.SiteURL = *serverURL
+			clientCfg.Cache = yts.DefaultCacheConfig()
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			for i := 0; i < 2; i++ {
+				assertError(t, methodName, tt.call(c), tt.wantErr)
+			}
+			assertEqual(t, methodName, counter.requests.Load(), int32(2))
+		})
+	}
+}
+
+func TestNewClientWithConfig_Cache(t *testing.T) {
+	const methodName = "NewClientWithConfig"
+
+	tests := []struct {
+		name     string
+		cacheCfg yts.CacheConfig
+		wantErr  error
+	}{
+		{
+			name:     "returns error for enabled cache without capacity or store",
+			cacheCfg: yts.CacheConfig{Enabled: true},
+			wantErr:  yts.ErrInvalidClientConfig,
+		},
+		{
+			name: "returns error for negative TTL",
+			cacheCfg: yts.CacheConfig{
+				Enabled:  true,
+				Capacity: 1,
+				TTLs:     map[yts.Endpoint]time.Duration{yts.EndpointMoviePage: -time.Second},
+			},
+			wantErr: yts.ErrInvalidClientConfig,
+		},
+		{
+			name:     "returns nil error for enabled cache with store",
+			cacheCfg: yts.CacheConfig{Enabled: true, Store: yts.NewMemoryCache(1)},
+			wantErr:  nil,
+		},
+		{
+			name:     "returns nil error for default cache config",
+			cacheCfg: yts.DefaultCacheConfig(),
+			wantErr:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.Cache = tt.cacheCfg
+			_, err := yts.NewClientWithConfig(&clientCfg)
+			assertError(t, methodName, err, tt.wantErr)
+		})
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	const methodName = "MemoryCache.Get"
+
+	var (
+		ctx   = context.Background()
+		cache = yts.NewMemoryCache(2)
+		entry = func(body string) *yts.CacheEntry {
+			return &yts.CacheEntry{Body: []byte(body)}
+		}
+	)
+
+	cache.Set(ctx, "a", entry("a"))
+	cache.Set(ctx, "b", entry("b"))
+	_, _ = cache.Get(ctx, "a")
+	cache.Set(ctx, "c", entry("c"))
+
+	_, ok := cache.Get(ctx, "b")
+	assertEqual(t, methodName, ok, false)
+
+	got, ok := cache.Get(ctx, "a")
+	assertEqual(t, methodName, ok, true)
+	assertEqual(t, methodName, got, entry("a"))
+	assertEqual(t, "MemoryCache.Len", cache.Len(), 2)
+
+	cache.Delete(ctx, "a")
+	_, ok = cache.Get(ctx, "a")
+	assertEqual(t, methodName, ok, false)
+
+	cache.Purge()
+	assertEqual(t, "MemoryCache.Len", cache.Len(), 0)
+}
+
+func TestClient_ConditionalRequests(t *testing.T) {
+	const (
+		methodName  = "Client.HomePageContent"
+		testdataDir = "homepage_content"
+		etag        = `"home-v1"`
+	)
+
+	var (
+		requests    = &atomic.Int32{}
+		notModified = &atomic.Int32{}
+	)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("If-None-Match") == etag {
+			notModified.Add(1)
+		}
+
+		w.Header().Set("ETag", etag)
+		http.ServeFile(w, r, path.Join("testdata", testdataDir, "ok_response.html"))
+	}
+
+	tests := []struct {
+		name             string
+		conditional      bool
+		wantNotModified  int32
+		wantRevalidation bool
+	}{
+		{
+			name:             "reuses stored body when server responds with 304",
+			conditional:      true,
+			wantNotModified:  1,
+			wantRevalidation: true,
+		},
+		{
+			name:             "makes unconditional requests when conditional requests are disabled",
+			conditional:      false,
+			wantNotModified:  0,
+			wantRevalidation: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests.Store(0)
+			notModified.Store(0)
+			server := httptest.NewServer(http.HandlerFunc(handler))
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.SiteURL = *serverURL
+			clientCfg.ConditionalRequests = tt.conditional
+
+			var metadata []yts.ResponseMetadata
+			ctx := yts.WithResponseMetadata(context.Background(), func(m yts.ResponseMetadata) {
+				metadata = append(metadata, m)
+			})
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			first, err := c.HomePageContentWithContext(ctx)
+			assertError(t, methodName, err, nil)
+
+			second, err := c.HomePageContentWithContext(ctx)
+			assertError(t, methodName, err, nil)
+			assertEqual(t, methodName, second, first)
+			assertEqual(t, methodName, requests.Load(), int32(2))
+			assertEqual(t, methodName, notModified.Load(), tt.wantNotModified)
+
+			assertEqual(t, methodName, len(metadata), 2)
+			assertEqual(t, methodName, metadata[0].StatusCode, http.StatusOK)
+			assertEqual(t, methodName, metadata[1].Revalidated, tt.wantRevalidation)
+			assertEqual(t, methodName, metadata[1].FromCache, tt.wantRevalidation)
+			assertEqual(t, methodName, metadata[1].Endpoint, yts.EndpointHomePageContent)
+		})
+	}
+}
+
+func TestClient_ResponseMetadataFromCache(t *testing.T) {
+	const (
+		methodName  = "Client.MovieSuggestions"
+		testdataDir = "movie_suggestions"
+		movieID     = 57427
+	)
+
+	server := createTestServer(t, defaultHandlerConfig(t, "/", testdataDir, "ok_response.json"))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.APIBaseURL = *serverURL
+	clientCfg.Cache = yts.DefaultCacheConfig()
+
+	var metadata []yts.ResponseMetadata
+	ctx := yts.WithResponseMetadata(context.Background(), func(m yts.ResponseMetadata) {
+		metadata = append(metadata, m)
+	})
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	_, _ = c.MovieSuggestionsWithContext(ctx, movieID)
+	_, _ = c.MovieSuggestionsWithContext(ctx, movieID)
+
+	assertEqual(t, methodName, len(metadata), 2)
+	assertEqual(t, methodName, metadata[0].FromCache, false)
+	assertEqual(t, methodName, metadata[1].FromCache, true)
+	assertEqual(t, methodName, metadata[1].StatusCode, 0)
+	if metadata[1].Age <= 0 {
+		t.Errorf("%s() metadata Age = %s, want > 0", methodName, metadata[1].Age)
+	}
+}
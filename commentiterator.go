@@ -0,0 +1,193 @@
+package yts
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// A CommentIterator walks every page of comments of a movie served by the following
+// endpoint /ajax/comments/{movie_id}?offset={offset}, yielding one comment at a
+// time, use it as shown below and call Close when you stop iterating early.
+//
+//	it, err := client.MovieCommentIterator("oppenheimer-2023", nil)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//
+//	for it.Next() {
+//		fmt.Println(it.Comment().Content)
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// A CommentIterator is not safe for concurrent use.
+type CommentIterator struct {
+	client       *Client
+	movieID      int
+	commentCount int
+	mode         ScrapeMode
+	pages        *pageIterator[SiteMovieComment]
+}
+
+// newCommentIterator returns a *CommentIterator over the comments of the movie with
+// the provided ID, the provided comment count being zero when it is not known.
+func (c *Client) newCommentIterator(
+	ctx context.Context, movieID, commentCount int, mode ScrapeMode, config *IteratorConfig,
+) (*CommentIterator, error) {
+	it := &CommentIterator{
+		client:       c,
+		movieID:      movieID,
+		commentCount: commentCount,
+		mode:         mode,
+	}
+
+	pages, err := newPageIterator(ctx, config, it.fetchPage, it.isLastPage)
+	if err != nil {
+		return nil, err
+	}
+
+	it.pages = pages
+	return it, nil
+}
+
+// MovieCommentIteratorWithContext is the same as the MovieCommentIterator method
+// but requires a context.Context argument to be passed, this context is then passed
+// to the http.NewRequestWithContext calls used for making the network requests,
+// and its ScrapeMode, see WithScrapeMode, is used for scraping the comments.
+func (c *Client) MovieCommentIteratorWithContext(
+	ctx context.Context, movieSlug string, config *IteratorConfig,
+) (*CommentIterator, error) {
+	page, err := c.MoviePageWithContext(ctx, movieSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	return page.CommentIteratorWithContext(ctx, config)
+}
+
+// MovieCommentIterator method fetches the movie page corresponding to the provided
+// movie slug once for resolving the movie ID, and returns a *CommentIterator over
+// every comment of the movie.
+func (c *Client) MovieCommentIterator(movieSlug string, config *IteratorConfig) (
+	*CommentIterator, error,
+) {
+	return c.MovieCommentIteratorWithContext(context.Background(), movieSlug, config)
+}
+
+// MovieCommentIteratorByIDWithContext is the same as the MovieCommentIteratorByID
+// method but requires a context.Context argument to be passed, this context is
+// then passed to the http.NewRequestWithContext calls used for making the network
+// requests, and its ScrapeMode, see WithScrapeMode, is used for scraping comments.
+func (c *Client) MovieCommentIteratorByIDWithContext(
+	ctx context.Context, movieID int, config *IteratorConfig,
+) (*CommentIterator, error) {
+	if movieID <= 0 {
+		err := fmt.Errorf("provided movieID must be at least 1")
+		return nil, wrapErr(ErrValidationFailure, err)
+	}
+
+	return c.newCommentIterator(ctx, movieID, 0, c.scrapeModeFrom(ctx), config)
+}
+
+// MovieCommentIteratorByID method returns a *CommentIterator over every comment of
+// the movie with the provided ID in the YTS movie database, which unlike the
+// MovieCommentIterator method does not require fetching the movie page, but since
+// the number of comments is then unknown it stops at the first empty page.
+func (c *Client) MovieCommentIteratorByID(movieID int, config *IteratorConfig) (
+	*CommentIterator, error,
+) {
+	return c.MovieCommentIteratorByIDWithContext(context.Background(), movieID, config)
+}
+
+// CommentIteratorWithContext is the same as the CommentIterator method but requires
+// a context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext calls used for making the network requests.
+func (p *MoviePage) CommentIteratorWithContext(ctx context.Context, config *IteratorConfig) (
+	*CommentIterator, error,
+) {
+	meta, err := p.commentsMetaData()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.client.newCommentIterator(ctx, meta.movieID, meta.commentCount, p.mode, config)
+}
+
+// CommentIterator method returns a *CommentIterator over every comment of the movie.
+func (p *MoviePage) CommentIterator(config *IteratorConfig) (*CommentIterator, error) {
+	return p.CommentIteratorWithContext(context.Background(), config)
+}
+
+func (it *CommentIterator) fetchPage(ctx context.Context, index int) pageResult[SiteMovieComment] {
+	var (
+		c      = it.client
+		offset = index * movieCommentsPerPage
+	)
+
+	commentURLString := c.getCommentsURL(it.movieID, offset)
+	commentURL, _ := url.Parse(commentURLString)
+	scrape := func(d *goquery.Document, postedRef time.Time) (pageResult[SiteMovieComment], error) {
+		comments, scrapeErrs, err := c.scrapeMovieComments(d, it.mode, postedRef)
+		if err != nil {
+			return pageResult[SiteMovieComment]{}, err
+		}
+
+		return pageResult[SiteMovieComment]{
+			items:      comments,
+			scrapeErrs: scrapeErrs,
+			size:       len(comments) + len(scrapeErrs),
+			perPage:    movieCommentsPerPage,
+			total:      it.commentCount,
+		}, nil
+	}
+
+	result, err := newDocumentRequestWithContext(ctx, c, EndpointMovieComments, commentURL, scrape)
+	if err != nil {
+		return pageResult[SiteMovieComment]{err: err}
+	}
+
+	return result
+}
+
+// isLastPage reports whether no page of comments follows the provided one, which
+// is the case once the comment count is reached or an empty page is served, the
+// replies served along with the comments of a page making its size unreliable.
+func (it *CommentIterator) isLastPage(result *pageResult[SiteMovieComment]) bool {
+	return result.size == 0 ||
+		(result.total > 0 && (result.index+1)*result.perPage >= result.total)
+}
+
+// Next advances the iterator to the next comment, which is then available through
+// the Comment method, it returns false once every comment has been yielded, the
+// MaxItems limit has been reached, an error has occurred or Close has been called.
+func (it *CommentIterator) Next() bool {
+	return it.pages.next()
+}
+
+// Comment returns the comment the iterator was advanced to by the last call to Next.
+func (it *CommentIterator) Comment() SiteMovieComment {
+	return it.pages.current
+}
+
+// Err returns the error which stopped the iteration if any.
+func (it *CommentIterator) Err() error {
+	return it.pages.err
+}
+
+// ScrapeErrors returns the comments which failed to scrape so far in
+// ScrapeModeLenient, see WithScrapeMode.
+func (it *CommentIterator) ScrapeErrors() []*ScrapeError {
+	return it.pages.scrapeErrs
+}
+
+// Close stops the iteration and cancels the prefetch of the next page if any, it
+// is safe to call Close more than once.
+func (it *CommentIterator) Close() {
+	it.pages.close()
+}
@@ -0,0 +1,235 @@
+package yts_test
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"testing"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+// commentPagesHandlerConfigs serves the movie page and the comment pages found in
+// the provided directory, the comment page for an offset being read from the
+// "comments_{offset}.html" file, and counts the comment page requests made.
+func commentPagesHandlerConfigs(
+	t *testing.T, dir string, counter *testRequestCounter, offsets ...int,
+) []testHTTPHandlerConfig {
+	t.Helper()
+	const (
+		movieSlug       = "oppenheimer-2023"
+		commentsPattern = "ajax/comments/57427"
+	)
+
+	configs := pagedHandlerConfigs(t, commentsPattern, dir, "offset", "comments_%d.html", counter, offsets...)
+	return append(configs, defaultHandlerConfig(t, path.Join("movies", movieSlug), dir, "movie_page.html"))
+}
+
+func TestClient_MovieCommentIteratorByID(t *testing.T) {
+	const (
+		methodName  = "Client.MovieCommentIteratorByID"
+		testdataDir = "movie_comment_iterator"
+		movieID     = 57427
+	)
+
+	tests := []struct {
+		name         string
+		movieID      int
+		config       *yts.IteratorConfig
+		offsets      []int
+		wantAuthors  int
+		wantLast     string
+		wantRequests int32
+		wantErr      error
+		wantIterErr  error
+	}{
+		{
+			name:    "returns error when movieID is less than 1",
+			movieID: 0,
+			config:  nil,
+			wantErr: yts.ErrValidationFailure,
+		},
+		{
+			name:    "returns error when max items is negative",
+			movieID: movieID,
+			config:  &yts.IteratorConfig{MaxItems: -1},
+			wantErr: yts.ErrValidationFailure,
+		},
+		{
+			name:         "yields every comment walking pages until an empty page",
+			movieID:      movieID,
+			config:       nil,
+			offsets:      []int{0, 30, 60, 90},
+			wantAuthors:  64,
+			wantLast:     "user63",
+			wantRequests: 4,
+		},
+		{
+			name:         "yields every comment when prefetching the next page",
+			movieID:      movieID,
+			config:       &yts.IteratorConfig{Prefetch: true},
+			offsets:      []int{0, 30, 60, 90},
+			wantAuthors:  64,
+			wantLast:     "user63",
+			wantRequests: 4,
+		},
+		{
+			name:         "stops yielding comments once max items is reached",
+			movieID:      movieID,
+			config:       &yts.IteratorConfig{MaxItems: 35},
+			offsets:      []int{0, 30, 60},
+			wantAuthors:  35,
+			wantLast:     "user34",
+			wantRequests: 2,
+		},
+		{
+			name:         "does not prefetch pages past max items",
+			movieID:      movieID,
+			config:       &yts.IteratorConfig{MaxItems: 10, Prefetch: true},
+			offsets:      []int{0, 30, 60},
+			wantAuthors:  10,
+			wantLast:     "user9",
+			wantRequests: 1,
+		},
+		{
+			name:         "stops iterating with error when a page fails",
+			movieID:      movieID,
+			config:       nil,
+			offsets:      []int{0},
+			wantAuthors:  30,
+			wantLast:     "user29",
+			wantRequests: 2,
+			wantIterErr:  yts.ErrUnexpectedHTTPResponseStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := &testRequestCounter{}
+			server := createTestServer(t, commentPagesHandlerConfigs(t, testdataDir, counter, tt.offsets...)...)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.SiteURL = *serverURL
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+
+			it, err := c.MovieCommentIteratorByIDWithContext(context.Background(), tt.movieID, tt.config)
+			assertError(t, methodName, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			defer it.Close()
+
+			var (
+				authors = 0
+				last    string
+			)
+			for it.Next() {
+				authors++
+				last = it.Comment().Author
+			}
+
+			assertError(t, methodName, it.Err(), tt.wantIterErr)
+			assertEqual(t, methodName, authors, tt.wantAuthors)
+			assertEqual(t, methodName, last, tt.wantLast)
+			assertEqual(t, methodName, counter.requests.Load(), tt.wantRequests)
+		})
+	}
+}
+
+func TestClient_MovieCommentIterator(t *testing.T) {
+	const (
+		methodName  = "Client.MovieCommentIterator"
+		testdataDir = "movie_comment_iterator"
+		movieSlug   = "oppenheimer-2023"
+	)
+
+	counter := &testRequestCounter{}
+	server := createTestServer(t, commentPagesHandlerConfigs(t, testdataDir, counter, 0, 30, 60)...)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.SiteURL = *serverURL
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+
+	it, err := c.MovieCommentIteratorWithContext(context.Background(), movieSlug, nil)
+	assertError(t, methodName, err, nil)
+	defer it.Close()
+
+	comments := 0
+	for it.Next() {
+		comments++
+	}
+
+	assertError(t, methodName, it.Err(), nil)
+	assertEqual(t, methodName, comments, 64)
+	assertEqual(t, methodName, counter.requests.Load(), int32(3))
+	assertEqual(t, methodName, it.Next(), false)
+}
+
+func TestClient_MovieCommentIteratorThreaded(t *testing.T) {
+	const (
+		methodName  = "Client.MovieCommentIterator"
+		testdataDir = "movie_comment_iterator_threaded"
+		movieSlug   = "oppenheimer-2023"
+		movieID     = 57427
+	)
+
+	tests := []struct {
+		name         string
+		byID         bool
+		wantRequests int32
+	}{
+		{
+			name:         "walks pages full of replies until the comment count is reached",
+			byID:         false,
+			wantRequests: 2,
+		},
+		{
+			name:         "walks pages full of replies until an empty page without comment count",
+			byID:         true,
+			wantRequests: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := &testRequestCounter{}
+			server := createTestServer(t, commentPagesHandlerConfigs(t, testdataDir, counter, 0, 30, 60)...)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.SiteURL = *serverURL
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+
+			var (
+				it  *yts.CommentIterator
+				err error
+			)
+			if tt.byID {
+				it, err = c.MovieCommentIteratorByIDWithContext(context.Background(), movieID, nil)
+			} else {
+				it, err = c.MovieCommentIteratorWithContext(context.Background(), movieSlug, nil)
+			}
+			assertError(t, methodName, err, nil)
+			defer it.Close()
+
+			var (
+				comments = 0
+				replies  = 0
+			)
+			for it.Next() {
+				comments++
+				replies += len(it.Comment().Replies)
+			}
+
+			assertError(t, methodName, it.Err(), nil)
+			assertEqual(t, methodName, comments, 20)
+			assertEqual(t, methodName, replies, 20)
+			assertEqual(t, methodName, counter.requests.Load(), tt.wantRequests)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package yts
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A TimePrecision indicates how precise a time parsed from a comment timestamp is,
+// relative timestamps such as "3 weeks ago" being only as precise as their unit.
+type TimePrecision int
+
+const (
+	// TimePrecisionNone indicates that the timestamp could not be parsed.
+	TimePrecisionNone TimePrecision = iota
+	TimePrecisionSecond
+	TimePrecisionMinute
+	TimePrecisionHour
+	TimePrecisionDay
+	TimePrecisionWeek
+	TimePrecisionMonth
+	TimePrecisionYear
+)
+
+func (p TimePrecision) String() string {
+	switch p {
+	case TimePrecisionNone:
+		return "none"
+	case TimePrecisionSecond:
+		return "second"
+	case TimePrecisionMinute:
+		return "minute"
+	case TimePrecisionHour:
+		return "hour"
+	case TimePrecisionDay:
+		return "day"
+	case TimePrecisionWeek:
+		return "week"
+	case TimePrecisionMonth:
+		return "month"
+	case TimePrecisionYear:
+		return "year"
+	default:
+		return fmt.Sprintf("TimePrecision(%d)", int(p))
+	}
+}
+
+// The layout of the absolute timestamps shown for comments e.g. "April 30, 2024
+// at 09:46 am", these are parsed in UTC.
+const commentTimestampLayout = "January 2, 2006 at 03:04 pm"
+
+var relativeTimestampRegex = regexp.MustCompile(
+	`^(\d+|an?|one) (second|minute|hour|day|week|month|year)s? ago$`,
+)
+
+var relativeTimestampPrecisions = map[string]TimePrecision{
+	"second": TimePrecisionSecond,
+	"minute": TimePrecisionMinute,
+	"hour":   TimePrecisionHour,
+	"day":    TimePrecisionDay,
+	"week":   TimePrecisionWeek,
+	"month":  TimePrecisionMonth,
+	"year":   TimePrecisionYear,
+}
+
+// subtractUnits returns the time n units of the provided precision before t.
+func subtractUnits(t time.Time, n int, precision TimePrecision) time.Time {
+	const daysPerWeek = 7
+
+	switch precision {
+	case TimePrecisionSecond:
+		return t.Add(-time.Duration(n) * time.Second)
+	case TimePrecisionMinute:
+		return t.Add(-time.Duration(n) * time.Minute)
+	case TimePrecisionHour:
+		return t.Add(-time.Duration(n) * time.Hour)
+	case TimePrecisionDay:
+		return t.AddDate(0, 0, -n)
+	case TimePrecisionWeek:
+		return t.AddDate(0, 0, -n*daysPerWeek)
+	case TimePrecisionMonth:
+		return t.AddDate(0, -n, 0)
+	case TimePrecisionYear:
+		return t.AddDate(-n, 0, 0)
+	case TimePrecisionNone:
+	}
+	return t
+}
+
+// ParseCommentTimestamp converts the provided comment timestamp, as found in the
+// Timestamp field of a SiteMovieComment, into a time.Time. Absolute timestamps e.g.
+// "April 30, 2024 at 09:46 am" are parsed in UTC, whereas relative timestamps e.g.
+// "3 weeks ago", "just now" or "yesterday" are resolved against the provided
+// reference time, the returned TimePrecision indicating how coarse the result is.
+func ParseCommentTimestamp(timestamp string, reference time.Time) (time.Time, TimePrecision, error) {
+	normalized := strings.ToLower(cleanString(timestamp))
+
+	if postedAt, err := time.Parse(commentTimestampLayout, normalized); err == nil {
+		return postedAt, TimePrecisionMinute, nil
+	}
+
+	switch normalized {
+	case "just now", "now":
+		return reference, TimePrecisionMinute, nil
+	case "today":
+		return reference, TimePrecisionDay, nil
+	case "yesterday":
+		return reference.AddDate(0, 0, -1), TimePrecisionDay, nil
+	}
+
+	match := relativeTimestampRegex.FindStringSubmatch(normalized)
+	if match == nil {
+		err := fmt.Errorf("unrecognized comment timestamp %q", timestamp)
+		return time.Time{}, TimePrecisionNone, wrapErr(ErrValidationFailure, err)
+	}
+
+	n, err := strconv.Atoi(match[1])
+	if err != nil {
+		n = 1
+	}
+
+	precision := relativeTimestampPrecisions[match[2]]
+	return subtractUnits(reference, n, precision), precision, nil
+}
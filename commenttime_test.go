@@ -0,0 +1,126 @@
+package yts_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"testing"
+	"time"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func TestParseCommentTimestamp(t *testing.T) {
+	const methodName = "ParseCommentTimestamp"
+
+	reference := time.Date(2024, time.May, 20, 12, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		timestamp     string
+		want          time.Time
+		wantPrecision yts.TimePrecision
+		wantErr       error
+	}{
+		{
+			name:          "parses absolute timestamp in UTC",
+			timestamp:     "April 30, 2024 at 09:46 pm",
+			want:          time.Date(2024, time.April, 30, 21, 46, 0, 0, time.UTC),
+			wantPrecision: yts.TimePrecisionMinute,
+		},
+		{
+			name:          "parses relative timestamp in minutes",
+			timestamp:     "5 minutes ago",
+			want:          reference.Add(-5 * time.Minute),
+			wantPrecision: yts.TimePrecisionMinute,
+		},
+		{
+			name:          "parses relative timestamp with article",
+			timestamp:     "an hour ago",
+			want:          reference.Add(-time.Hour),
+			wantPrecision: yts.TimePrecisionHour,
+		},
+		{
+			name:          "parses relative timestamp in weeks",
+			timestamp:     " 3 weeks  ago ",
+			want:          reference.AddDate(0, 0, -21),
+			wantPrecision: yts.TimePrecisionWeek,
+		},
+		{
+			name:          "parses relative timestamp in months",
+			timestamp:     "2 months ago",
+			want:          reference.AddDate(0, -2, 0),
+			wantPrecision: yts.TimePrecisionMonth,
+		},
+		{
+			name:          "parses relative timestamp in years",
+			timestamp:     "a year ago",
+			want:          reference.AddDate(-1, 0, 0),
+			wantPrecision: yts.TimePrecisionYear,
+		},
+		{
+			name:          "parses yesterday",
+			timestamp:     "Yesterday",
+			want:          reference.AddDate(0, 0, -1),
+			wantPrecision: yts.TimePrecisionDay,
+		},
+		{
+			name:          "parses just now",
+			timestamp:     "just now",
+			want:          reference,
+			wantPrecision: yts.TimePrecisionMinute,
+		},
+		{
+			name:          "returns error for unrecognized timestamp",
+			timestamp:     "sometime last summer",
+			want:          time.Time{},
+			wantPrecision: yts.TimePrecisionNone,
+			wantErr:       yts.ErrValidationFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, precision, err := yts.ParseCommentTimestamp(tt.timestamp, reference)
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, got, tt.want)
+			assertEqual(t, methodName, precision, tt.wantPrecision)
+		})
+	}
+}
+
+func TestClient_CommentPostedAt(t *testing.T) {
+	const (
+		methodName  = "Client.MovieCommentIteratorByID"
+		testdataDir = "comment_timestamps"
+		movieID     = 57427
+	)
+
+	date := time.Date(2024, time.May, 20, 12, 30, 0, 0, time.UTC)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Date", date.Format(http.TimeFormat))
+		http.ServeFile(w, r, path.Join("testdata", testdataDir, "comments.html"))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.SiteURL = *serverURL
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+
+	it, err := c.MovieCommentIteratorByIDWithContext(context.Background(), movieID, nil)
+	assertError(t, methodName, err, nil)
+	defer it.Close()
+
+	if !it.Next() {
+		t.Fatalf("%s() yielded no comments, error = %v", methodName, it.Err())
+	}
+
+	comment := it.Comment()
+	assertEqual(t, methodName, comment.Timestamp, "3 weeks ago")
+	assertEqual(t, methodName, comment.PostedAt, date.AddDate(0, 0, -21))
+	assertEqual(t, methodName, comment.PostedAtPrecision, yts.TimePrecisionWeek)
+}
@@ -40,6 +40,13 @@ modifying it to suit your needs.
 	config.RequestTimeout = time.Minute * 2
 	client, err := NewClientWithConfig(&config)
 
+The client reports requests, retries, mirror failovers and scraping failures to a
+structured *slog.Logger, you can provide your own in place of the Debug flag.
+
+	config := DefaultClientConfig()
+	config.Logger = slog.New(slog.NewJSONHandler(os.Stderr, nil))
+	client, err := NewClientWithConfig(&config)
+
 With the the *yts.Client instance instantiated you can leverage the methods provided
 by the client in the following manner.
 
@@ -3,6 +3,8 @@ package yts
 import (
 	"fmt"
 	"net/url"
+	"regexp"
+	"strings"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -290,3 +292,176 @@ func (f *MovieDetailsFilters) getQueryString() string {
 
 	return queryValues.Encode()
 }
+
+// Represents all possible values for the "order" path segment of the
+// "/browse-movies" page of the YTS website.
+type Order string
+
+const (
+	OrderLatest       Order = "latest"
+	OrderOldest       Order = "oldest"
+	OrderFeatured     Order = "featured"
+	OrderSeeds        Order = "seeds"
+	OrderPeers        Order = "peers"
+	OrderYear         Order = "year"
+	OrderRating       Order = "rating"
+	OrderLikes        Order = "likes"
+	OrderRTAudience   Order = "rt_audience"
+	OrderAlphabetical Order = "alphabetical"
+	OrderDownloads    Order = "downloads"
+)
+
+// Represents the "year" path segment of the "/browse-movies" page of the YTS
+// website, which is either YearAll, a single year e.g. "2023", or an inclusive
+// range of years e.g. "2020-2024", see the YearOf and YearRange functions.
+type Year string
+
+// YearAll matches movies released in any year.
+const YearAll Year = "0"
+
+// YearOf returns the Year matching movies released in the provided year.
+func YearOf(year int) Year {
+	return Year(fmt.Sprintf("%d", year))
+}
+
+// YearRange returns the Year matching movies released between the provided years.
+func YearRange(from, to int) Year {
+	return Year(fmt.Sprintf("%d-%d", from, to))
+}
+
+// Represents the "language" path segment of the "/browse-movies" page of the YTS
+// website, which is either LanguageAll, LanguageForeign or the ISO 639-1 code of
+// the language of the movie, the most common of which are provided below.
+type Language string
+
+const (
+	LanguageAll      Language = "all"
+	LanguageForeign  Language = "foreign"
+	LanguageEnglish  Language = "en"
+	LanguageFrench   Language = "fr"
+	LanguageGerman   Language = "de"
+	LanguageSpanish  Language = "es"
+	LanguageItalian  Language = "it"
+	LanguageJapanese Language = "ja"
+	LanguageKorean   Language = "ko"
+	LanguageChinese  Language = "zh"
+	LanguageHindi    Language = "hi"
+	LanguageRussian  Language = "ru"
+)
+
+var (
+	yearPattern     = regexp.MustCompile(`^(0|\d{4}|\d{4}-\d{4})$`)
+	languagePattern = regexp.MustCompile(`^(all|foreign|[a-z]{2})$`)
+)
+
+// A BrowseMoviesFilters represents the complete set of filters (path segments)
+// that can be provided for the following page of the YTS website, which unlike
+// the "/api/v2/list_movies.json" endpoint supports year ranges and languages.
+// "/browse-movies/{keyword}/{quality}/{genre}/{rating}/{order}/{year}/{language}"
+type BrowseMoviesFilters struct {
+	Keyword       string   `json:"keyword"`
+	Quality       Quality  `json:"quality"`
+	Genre         Genre    `json:"genre"`
+	MinimumRating int      `json:"minimum_rating"`
+	Order         Order    `json:"order"`
+	Year          Year     `json:"year"`
+	Language      Language `json:"language"`
+	Page          int      `json:"page"`
+}
+
+// DefaultBrowseMoviesFilters returns the default *BrowseMoviesFilters for the given
+// keyword, these match the filters applied by the YTS website when none are chosen.
+func DefaultBrowseMoviesFilters(keyword string) *BrowseMoviesFilters {
+	return &BrowseMoviesFilters{
+		Keyword:       keyword,
+		Quality:       QualityAll,
+		Genre:         GenreAll,
+		MinimumRating: 0,
+		Order:         OrderLatest,
+		Year:          YearAll,
+		Language:      LanguageAll,
+		Page:          1,
+	}
+}
+
+func (f *BrowseMoviesFilters) validateFilters() error {
+	const maxMinRating = 9
+
+	return validation.ValidateStruct(
+		f,
+		validation.Field(
+			&f.Quality,
+			validation.Required,
+			validateQualityRule,
+		),
+		validation.Field(
+			&f.Genre,
+			validation.Required,
+			validateGenreRule,
+		),
+		validation.Field(
+			&f.MinimumRating,
+			validation.Min(0),
+			validation.Max(maxMinRating),
+		),
+		validation.Field(
+			&f.Order,
+			validation.Required,
+			validation.In(
+				OrderLatest,
+				OrderOldest,
+				OrderFeatured,
+				OrderSeeds,
+				OrderPeers,
+				OrderYear,
+				OrderRating,
+				OrderLikes,
+				OrderRTAudience,
+				OrderAlphabetical,
+				OrderDownloads,
+			),
+		),
+		validation.Field(
+			&f.Year,
+			validation.Required,
+			validation.Match(yearPattern),
+		),
+		validation.Field(
+			&f.Language,
+			validation.Required,
+			validation.Match(languagePattern),
+		),
+		validation.Field(
+			&f.Page,
+			validation.Min(1),
+		),
+	)
+}
+
+func (f *BrowseMoviesFilters) getPath() (string, error) {
+	if err := f.validateFilters(); err != nil {
+		return "", err
+	}
+
+	keyword := url.PathEscape(f.Keyword)
+	if keyword == "" {
+		keyword = "0"
+	}
+
+	path := fmt.Sprintf(
+		"/browse-movies/%s/%s/%s/%d/%s/%s/%s",
+		keyword,
+		f.Quality,
+		strings.ToLower(string(f.Genre)),
+		f.MinimumRating,
+		f.Order,
+		f.Year,
+		f.Language,
+	)
+
+	if f.Page > 1 {
+		path = fmt.Sprintf("%s?page=%d", path, f.Page)
+	}
+
+	return path, nil
+}
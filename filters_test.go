@@ -33,3 +33,20 @@ func TestDefaultMovieDetailsFilters(t *testing.T) {
 
 	assertEqual(t, "DefaultMovieDetailsFilters", got, want)
 }
+
+func TestDefaultBrowseMoviesFilters(t *testing.T) {
+	const keyword = "Oppenheimer"
+	got := yts.DefaultBrowseMoviesFilters(keyword)
+	want := &yts.BrowseMoviesFilters{
+		Keyword:       keyword,
+		Quality:       yts.QualityAll,
+		Genre:         yts.GenreAll,
+		MinimumRating: 0,
+		Order:         yts.OrderLatest,
+		Year:          yts.YearAll,
+		Language:      yts.LanguageAll,
+		Page:          1,
+	}
+
+	assertEqual(t, "DefaultBrowseMoviesFilters", got, want)
+}
@@ -4,11 +4,11 @@ go 1.21.5
 
 require (
 	github.com/PuerkitoBio/goquery v1.8.1
+	github.com/andybalholm/cascadia v1.3.1
 	github.com/go-ozzo/ozzo-validation/v4 v4.3.0
 )
 
 require (
-	github.com/andybalholm/cascadia v1.3.1 // indirect
 	github.com/asaskevich/govalidator v0.0.0-20200108200545-475eaeb16496 // indirect
 	golang.org/x/net v0.8.0 // indirect
 )
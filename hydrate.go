@@ -0,0 +1,184 @@
+package yts
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// movieIDCacheCapacity is the number of movie slugs for which a client remembers
+// the ID the slug was resolved to, the least recently used slugs being evicted.
+const movieIDCacheCapacity = 1024
+
+// cachedMovieID returns the ID the provided movie slug was last resolved to, since
+// the ID of a movie in the YTS movie database never changes.
+func (c *Client) cachedMovieID(ctx context.Context, movieSlug string) (int, bool) {
+	entry, ok := c.movieIDs.Get(ctx, movieSlug)
+	if !ok {
+		return 0, false
+	}
+
+	movieID, err := strconv.Atoi(string(entry.Body))
+	return movieID, err == nil
+}
+
+func (c *Client) cacheMovieID(ctx context.Context, movieSlug string, movieID int) {
+	entry := &CacheEntry{Body: []byte(strconv.Itoa(movieID)), StoredAt: time.Now()}
+	c.movieIDs.Set(ctx, movieSlug, entry)
+}
+
+// A HydrateConfig allows you to configure the behavior of the Hydrate method, a
+// nil or zero value HydrateConfig hydrates DefaultBatchConcurrency movies at once.
+type HydrateConfig struct {
+	// The maximum number of movies hydrated concurrently, the requests made remain
+	// subject to the RateLimit of the client, zero meaning DefaultBatchConcurrency.
+	Concurrency int
+}
+
+func (cfg *HydrateConfig) validate() error {
+	if cfg.Concurrency < 0 {
+		return fmt.Errorf("provided concurrency cannot be negative")
+	}
+	return nil
+}
+
+// A HydratedMovie pairs a SiteMovie with the MovieDetails of the same movie as
+// returned by the YTS API, exactly one of Details and Err is set.
+type HydratedMovie struct {
+	SiteMovie SiteMovie
+	Details   *MovieDetails
+	Err       error
+}
+
+// movieSlugResolution holds the outcome of resolving a movie slug to the ID of
+// the movie.
+type movieSlugResolution struct {
+	movieID int
+	err     error
+}
+
+// resolveMovieSlugs resolves each of the provided movie slugs to the ID of the
+// movie, see the ResolveMovieSlugToID method, resolving concurrency slugs at once.
+// Slugs resolved by previous calls are not resolved again.
+func (c *Client) resolveMovieSlugs(
+	ctx context.Context, movieSlugs []string, concurrency int,
+) map[string]movieSlugResolution {
+	resolve := func(ctx context.Context, movieSlug string) (int, error) {
+		if movieID, ok := c.cachedMovieID(ctx, movieSlug); ok {
+			return movieID, nil
+		}
+
+		movieID, err := c.ResolveMovieSlugToIDWithContext(ctx, movieSlug)
+		if err != nil {
+			return 0, err
+		}
+
+		c.cacheMovieID(ctx, movieSlug, movieID)
+		return movieID, nil
+	}
+
+	resolved := make(map[string]movieSlugResolution, len(movieSlugs))
+	runBatch(ctx, movieSlugs, concurrency, resolve,
+		func(movieSlug string, movieID int, err error) {
+			resolved[movieSlug] = movieSlugResolution{movieID: movieID, err: err}
+		},
+	)
+	return resolved
+}
+
+// HydrateWithContext is the same as the Hydrate method but requires a
+// context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext calls used for making the network requests, once it
+// is done the movies not yet hydrated fail with the error of the context.
+func (c *Client) HydrateWithContext(
+	ctx context.Context, movies []SiteMovie, filters *MovieDetailsFilters, config *HydrateConfig,
+) ([]HydratedMovie, error) {
+	if filters == nil {
+		err := fmt.Errorf("provided filters cannot be nil")
+		return nil, wrapErr(ErrFilterValidationFailure, err)
+	}
+
+	var hydrateConfig HydrateConfig
+	if config != nil {
+		hydrateConfig = *config
+	}
+
+	if err := hydrateConfig.validate(); err != nil {
+		return nil, wrapErr(ErrValidationFailure, err)
+	}
+
+	concurrency := hydrateConfig.Concurrency
+	if concurrency == 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	var (
+		hydrated   = make([]HydratedMovie, len(movies))
+		movieSlugs = make([]string, len(movies))
+		validSlugs = make([]string, 0, len(movies))
+	)
+
+	for i, movie := range movies {
+		hydrated[i].SiteMovie = movie
+		if movieSlugs[i] = movieSlugFromLink(movie.Link); movieSlugs[i] != "" {
+			validSlugs = append(validSlugs, movieSlugs[i])
+		}
+	}
+
+	var (
+		uniqueSlugs = uniqueValues(validSlugs)
+		resolved    = c.resolveMovieSlugs(ctx, uniqueSlugs, concurrency)
+		movieIDs    = make([]int, 0, len(uniqueSlugs))
+	)
+
+	for _, movieSlug := range uniqueSlugs {
+		if resolution := resolved[movieSlug]; resolution.err == nil {
+			movieIDs = append(movieIDs, resolution.movieID)
+		}
+	}
+
+	batchConfig := &MovieDetailsBatchConfig{Concurrency: concurrency}
+	batch, err := c.MovieDetailsBatchWithContext(ctx, movieIDs, filters, batchConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, movie := range movies {
+		if movieSlugs[i] == "" {
+			linkErr := fmt.Errorf("provided movie link %q is not a link to a movie page", movie.Link)
+			hydrated[i].Err = wrapErr(ErrValidationFailure, linkErr)
+			continue
+		}
+
+		resolution := resolved[movieSlugs[i]]
+		if resolution.err != nil {
+			hydrated[i].Err = resolution.err
+			continue
+		}
+
+		result := batch[resolution.movieID]
+		if result.Err != nil {
+			hydrated[i].Err = result.Err
+			continue
+		}
+		hydrated[i].Details = &result.Response.Data.Movie
+	}
+
+	return hydrated, nil
+}
+
+// Hydrate method fetches the MovieDetails of each of the provided movies, as
+// scraped by the TrendingMovies and HomePageContent methods, by extracting the
+// movie slug from its Link, resolving the slug to the ID of the movie, see the
+// ResolveMovieSlugToID method, and fetching the details of the movie with the
+// provided filters, see the MovieDetailsBatch method. Movies are hydrated
+// concurrently and each distinct movie requested only once per call, while the IDs
+// movie slugs are resolved to are remembered by the client across calls. The
+// returned HydratedMovie instances follow the order of the provided movies and the
+// failure for one movie does not affect the others.
+func (c *Client) Hydrate(movies []SiteMovie, filters *MovieDetailsFilters, config *HydrateConfig) (
+	[]HydratedMovie, error,
+) {
+	return c.HydrateWithContext(context.Background(), movies, filters, config)
+}
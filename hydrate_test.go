@@ -0,0 +1,190 @@
+package yts_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+const (
+	hydrateMovieID    = 3175
+	hydrateMovieLink  = "https://yts.mx/movies/the-dark-knight-2008"
+	hydrateBrokenLink = "https://yts.mx/movies/missing-selector-2008"
+	hydrateBrowseLink = "https://yts.mx/browse-movies"
+)
+
+// hydrateHandlerConfigs serves the movie pages and the movie details requested when
+// hydrating movies, and counts the movie page and movie details requests made.
+func hydrateHandlerConfigs(t *testing.T, pageCounter, detailsCounter *testRequestCounter) []testHTTPHandlerConfig {
+	t.Helper()
+	const (
+		pagesDir      = "resolve_movie_slug"
+		detailsDir    = "hydrate"
+		responseDelay = 20 * time.Millisecond
+	)
+
+	var (
+		movieCfg   = defaultHandlerConfig(t, "movies/the-dark-knight-2008", pagesDir, "ok_response.html")
+		brokenCfg  = defaultHandlerConfig(t, "movies/missing-selector-2008", pagesDir, "missing_selector.html")
+		detailsCfg = defaultHandlerConfig(t, "api/v2/movie_details.json", detailsDir, "movie_details.json")
+	)
+
+	return []testHTTPHandlerConfig{
+		movieCfg.withDelay(responseDelay).withCounter(pageCounter),
+		brokenCfg.withDelay(responseDelay).withCounter(pageCounter),
+		detailsCfg.withQuery(fmt.Sprintf("movie_id=%d", hydrateMovieID)).withCounter(detailsCounter),
+	}
+}
+
+func newHydrateSiteMovie(link string) yts.SiteMovie {
+	return yts.SiteMovie{
+		SiteMovieBase: yts.SiteMovieBase{Title: "The Dark Knight", Year: 2008, Link: link},
+		Rating:        "9.1 / 10",
+	}
+}
+
+func TestClient_HydrateWithContext(t *testing.T) {
+	const methodName = "Client.Hydrate"
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name                string
+		ctx                 context.Context
+		links               []string
+		filters             *yts.MovieDetailsFilters
+		config              *yts.HydrateConfig
+		wantErrs            []error
+		wantPageRequests    int32
+		wantDetailsRequests int32
+		wantErr             error
+	}{
+		{
+			name:    "returns error when filters are nil",
+			ctx:     context.Background(),
+			links:   []string{hydrateMovieLink},
+			filters: nil,
+			config:  nil,
+			wantErr: yts.ErrFilterValidationFailure,
+		},
+		{
+			name:    "returns error when concurrency is negative",
+			ctx:     context.Background(),
+			links:   []string{hydrateMovieLink},
+			filters: yts.DefaultMovieDetailsFilters(),
+			config:  &yts.HydrateConfig{Concurrency: -1},
+			wantErr: yts.ErrValidationFailure,
+		},
+		{
+			name:                "returns empty results when no movies are provided",
+			ctx:                 context.Background(),
+			links:               nil,
+			filters:             yts.DefaultMovieDetailsFilters(),
+			config:              nil,
+			wantErrs:            []error{},
+			wantPageRequests:    0,
+			wantDetailsRequests: 0,
+		},
+		{
+			name:                "hydrates movies resolving and requesting each movie only once",
+			ctx:                 context.Background(),
+			links:               []string{hydrateMovieLink, hydrateMovieLink, hydrateMovieLink},
+			filters:             yts.DefaultMovieDetailsFilters(),
+			config:              &yts.HydrateConfig{Concurrency: 3},
+			wantErrs:            []error{nil, nil, nil},
+			wantPageRequests:    1,
+			wantDetailsRequests: 1,
+		},
+		{
+			name:                "returns error per movie without failing the others",
+			ctx:                 context.Background(),
+			links:               []string{hydrateBrowseLink, hydrateBrokenLink, hydrateMovieLink},
+			filters:             yts.DefaultMovieDetailsFilters(),
+			config:              nil,
+			wantErrs:            []error{yts.ErrValidationFailure, yts.ErrContentRetrievalFailure, nil},
+			wantPageRequests:    2,
+			wantDetailsRequests: 1,
+		},
+		{
+			name:                "returns context error per movie when context is canceled",
+			ctx:                 canceledCtx,
+			links:               []string{hydrateMovieLink, hydrateBrokenLink},
+			filters:             yts.DefaultMovieDetailsFilters(),
+			config:              nil,
+			wantErrs:            []error{context.Canceled, context.Canceled},
+			wantPageRequests:    0,
+			wantDetailsRequests: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pageCounter, detailsCounter := &testRequestCounter{}, &testRequestCounter{}
+			server := createTestServer(t, hydrateHandlerConfigs(t, pageCounter, detailsCounter)...)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.SiteURL = *serverURL
+			clientCfg.APIBaseURL = *serverURL.JoinPath("api", "v2")
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+
+			movies := make([]yts.SiteMovie, 0, len(tt.links))
+			for _, link := range tt.links {
+				movies = append(movies, newHydrateSiteMovie(link))
+			}
+
+			got, err := c.HydrateWithContext(tt.ctx, movies, tt.filters, tt.config)
+			assertError(t, methodName, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+
+			assertEqual(t, methodName, len(got), len(tt.wantErrs))
+			for i, wantErr := range tt.wantErrs {
+				assertEqual(t, methodName, got[i].SiteMovie, movies[i])
+				assertError(t, methodName, got[i].Err, wantErr)
+				if wantErr == nil {
+					assertEqual(t, methodName, got[i].Details.ID, hydrateMovieID)
+				}
+			}
+			assertEqual(t, methodName, pageCounter.requests.Load(), tt.wantPageRequests)
+			assertEqual(t, methodName, detailsCounter.requests.Load(), tt.wantDetailsRequests)
+		})
+	}
+}
+
+func TestClient_HydrateCachesMovieIDs(t *testing.T) {
+	const methodName = "Client.Hydrate"
+
+	pageCounter, detailsCounter := &testRequestCounter{}, &testRequestCounter{}
+	server := createTestServer(t, hydrateHandlerConfigs(t, pageCounter, detailsCounter)...)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.SiteURL = *serverURL
+	clientCfg.APIBaseURL = *serverURL.JoinPath("api", "v2")
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+
+	movies := []yts.SiteMovie{
+		newHydrateSiteMovie(hydrateMovieLink),
+		newHydrateSiteMovie(hydrateBrokenLink),
+	}
+
+	for i := 0; i < 2; i++ {
+		got, err := c.Hydrate(movies, yts.DefaultMovieDetailsFilters(), nil)
+		assertError(t, methodName, err, nil)
+		assertEqual(t, methodName, got[0].Details.ID, hydrateMovieID)
+		assertError(t, methodName, got[1].Err, yts.ErrContentRetrievalFailure)
+	}
+
+	// The slug which failed to resolve is resolved again by the second call.
+	assertEqual(t, methodName, pageCounter.requests.Load(), int32(3))
+	assertEqual(t, methodName, detailsCounter.requests.Load(), int32(2))
+}
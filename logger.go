@@ -1,39 +1,40 @@
 package yts
 
 import (
-	"log"
+	"context"
+	"log/slog"
 	"os"
-	"sync"
 )
 
-type debugWriter struct {
-	debug bool
-	mu    sync.Mutex
-}
+// discardHandler is a slog.Handler that drops every record, it is used when
+// neither a Logger nor the Debug flag have been provided in the ClientConfig.
+type discardHandler struct{}
 
-func (dw *debugWriter) setDebug(debug bool) {
-	dw.debug = debug
-}
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (d discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return d }
+func (d discardHandler) WithGroup(string) slog.Handler           { return d }
 
-func (dw *debugWriter) Write(p []byte) (int, error) {
-	dw.mu.Lock()
-	defer dw.mu.Unlock()
-	if !dw.debug {
-		return 0, nil
+func newLogger(config *ClientConfig) *slog.Logger {
+	if config.Logger != nil {
+		return config.Logger
 	}
 
-	return os.Stdout.Write(p)
-}
+	if config.Debug {
+		options := &slog.HandlerOptions{Level: slog.LevelDebug}
+		return slog.New(slog.NewTextHandler(os.Stdout, options))
+	}
 
-type logger struct {
-	log.Logger
-	debugWriter
+	return slog.New(discardHandler{})
 }
 
-func newLogger() *logger {
-	l := &logger{}
-	l.SetFlags(log.Ldate | log.Ltime | log.Lshortfile)
-	l.SetPrefix("(yflicks-yts): ")
-	l.SetOutput(&l.debugWriter)
-	return l
+func (c *Client) logScrapingFailure(err *ScrapeError) {
+	c.logger.Warn(
+		"scraping failed",
+		slog.String("url", err.URL),
+		slog.String("selector", err.Selector),
+		slog.String("section", err.Section),
+		slog.Int("index", err.Index),
+		slog.Any("error", err.Err),
+	)
 }
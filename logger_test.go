@@ -0,0 +1,103 @@
+package yts_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/url"
+	"testing"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func decodeLogRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	records := make([]map[string]any, 0)
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		record := make(map[string]any)
+		if err := decoder.Decode(&record); err != nil {
+			t.Fatalf("failed to decode log record, %s", err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestClient_Logger(t *testing.T) {
+	const (
+		methodName  = "Client.TrendingMovies"
+		testdataDir = "trending_movies"
+		pattern     = "/"
+	)
+
+	tests := []struct {
+		name        string
+		filename    string
+		wantErr     error
+		wantMessage string
+		wantLevel   string
+		wantAttrs   map[string]any
+	}{
+		{
+			name:        "logs completed requests at debug level",
+			filename:    "ok_response.html",
+			wantErr:     nil,
+			wantMessage: "request completed",
+			wantLevel:   slog.LevelDebug.String(),
+			wantAttrs: map[string]any{
+				"endpoint":   string(yts.EndpointTrendingMovies),
+				"status":     float64(200),
+				"attempts":   float64(1),
+				"from_cache": false,
+			},
+		},
+		{
+			name:        "logs scraping failures at warn level",
+			filename:    "missing_selector.html",
+			wantErr:     yts.ErrContentRetrievalFailure,
+			wantMessage: "scraping failed",
+			wantLevel:   slog.LevelWarn.String(),
+			wantAttrs: map[string]any{
+				"selector": "div.browse-movie-wrap",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handlerCfg := defaultHandlerConfig(t, pattern, testdataDir, tt.filename)
+			server := createTestServer(t, handlerCfg)
+			defer server.Close()
+
+			var (
+				buf          = &bytes.Buffer{}
+				options      = &slog.HandlerOptions{Level: slog.LevelDebug}
+				serverURL, _ = url.Parse(server.URL)
+				clientCfg    = yts.DefaultClientConfig()
+			)
+
+			clientCfg.SiteURL = *serverURL
+			clientCfg.Logger = slog.New(slog.NewJSONHandler(buf, options))
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			_, err := c.TrendingMoviesWithContext(context.Background())
+			assertError(t, methodName, err, tt.wantErr)
+
+			for _, record := range decodeLogRecords(t, buf) {
+				if record[slog.MessageKey] != tt.wantMessage {
+					continue
+				}
+
+				assertEqual(t, methodName, record[slog.LevelKey], tt.wantLevel)
+				for key, want := range tt.wantAttrs {
+					assertEqual(t, methodName, record[key], want)
+				}
+				return
+			}
+
+			t.Errorf("%s() logged no %q record", methodName, tt.wantMessage)
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package yts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMirrorCooldown is the value of the MirrorCooldown field for the
+// ClientConfig instance returned by the DefaultClientConfig() function.
+const DefaultMirrorCooldown = 5 * time.Minute
+
+// A MirrorStatus instance describes the health of a single YTS API or YTS website
+// mirror used by a `yts.Client`, see the MirrorStatuses method.
+type MirrorStatus struct {
+	// The base URL of the mirror.
+	URL string `json:"url"`
+
+	// This flag is set for the mirror currently used for making requests, the
+	// client sticks to the last healthy mirror until it fails.
+	Active bool `json:"active"`
+
+	// This flag is unset while the mirror is cooling down after a failure, during
+	// which it is only tried once all healthy mirrors have failed.
+	Healthy bool `json:"healthy"`
+
+	// The number of consecutive failed requests made to the mirror.
+	ConsecutiveFailures int `json:"consecutive_failures"`
+}
+
+// A MirrorStatuses instance holds the MirrorStatus of every YTS API and YTS
+// website mirror used by a `yts.Client`, in the order in which they are tried.
+type MirrorStatuses struct {
+	API  []MirrorStatus `json:"api"`
+	Site []MirrorStatus `json:"site"`
+}
+
+type mirrorPool struct {
+	mu             sync.Mutex
+	mirrors        []url.URL
+	cooldown       time.Duration
+	active         int
+	failures       []int
+	unhealthyUntil []time.Time
+}
+
+func newMirrorPool(primary url.URL, mirrors []url.URL, cooldown time.Duration) *mirrorPool {
+	all := append([]url.URL{primary}, mirrors...)
+	return &mirrorPool{
+		mirrors:        all,
+		cooldown:       cooldown,
+		failures:       make([]int, len(all)),
+		unhealthyUntil: make([]time.Time, len(all)),
+	}
+}
+
+// candidates returns the indices of the mirrors in the order in which they should
+// be tried, starting with the active mirror and leaving unhealthy mirrors last.
+func (mp *mirrorPool) candidates(now time.Time) []int {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	var (
+		healthy   = make([]int, 0, len(mp.mirrors))
+		unhealthy = make([]int, 0)
+	)
+
+	for n := 0; n < len(mp.mirrors); n++ {
+		i := (mp.active + n) % len(mp.mirrors)
+		if now.Before(mp.unhealthyUntil[i]) {
+			unhealthy = append(unhealthy, i)
+			continue
+		}
+		healthy = append(healthy, i)
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+func (mp *mirrorPool) markSuccess(i int) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	mp.active = i
+	mp.failures[i] = 0
+	mp.unhealthyUntil[i] = time.Time{}
+}
+
+func (mp *mirrorPool) markFailure(i int, now time.Time) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	mp.failures[i]++
+	mp.unhealthyUntil[i] = now.Add(mp.cooldown)
+}
+
+func (mp *mirrorPool) statuses(now time.Time) []MirrorStatus {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	statuses := make([]MirrorStatus, 0, len(mp.mirrors))
+	for i := range mp.mirrors {
+		statuses = append(statuses, MirrorStatus{
+			URL:                 mp.mirrors[i].String(),
+			Active:              i == mp.active,
+			Healthy:             !now.Before(mp.unhealthyUntil[i]),
+			ConsecutiveFailures: mp.failures[i],
+		})
+	}
+	return statuses
+}
+
+func (mp *mirrorPool) activeMirror() url.URL {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	return mp.mirrors[mp.active]
+}
+
+// rewrite returns the provided URL, built against the primary base URL of the
+// pool, with its base URL replaced with the mirror at the provided index.
+func (mp *mirrorPool) rewrite(targetURL *url.URL, i int) (*url.URL, string) {
+	var (
+		primary = mp.mirrors[0].String()
+		mirror  = mp.mirrors[i].String()
+		target  = targetURL.String()
+	)
+
+	if i == 0 || !strings.HasPrefix(target, primary) {
+		return targetURL, primary
+	}
+
+	rewritten, err := url.Parse(mirror + strings.TrimPrefix(target, primary))
+	if err != nil {
+		return targetURL, primary
+	}
+	return rewritten, mirror
+}
+
+var failoverErrorClasses = RetryPolicy{
+	RetryableErrors: RetryOnTimeout | RetryOnConnectionError | RetryOnDNSError,
+}
+
+func isFailoverWorthy(ctx context.Context, response *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if response != nil {
+		return response.StatusCode >= http.StatusInternalServerError
+	}
+
+	return err != nil && failoverErrorClasses.isRetryableError(err)
+}
+
+// servesHost reports whether the provided host is the host of any of the mirrors.
+func (mp *mirrorPool) servesHost(host string) bool {
+	for i := range mp.mirrors {
+		if mp.mirrors[i].Host == host {
+			return true
+		}
+	}
+	return false
+}
+
+// mirrorPoolFor returns the mirror pool and rate limiter used for the provided URL,
+// the returned flag being unset for URLs outside the YTS API and the YTS website,
+// such as the IMDb review pages linked from movie pages, which have no mirror pool
+// and are paced by the off-site rate limiter.
+func (c *Client) mirrorPoolFor(targetURL *url.URL) (*mirrorPool, *tokenBucket, bool) {
+	apiBaseURL := c.config.APIBaseURL.String()
+	switch {
+	case strings.HasPrefix(targetURL.String(), apiBaseURL):
+		return c.apiMirrors, c.apiLimiter, true
+	case c.siteMirrors.servesHost(targetURL.Host):
+		return c.siteMirrors, c.siteLimiter, true
+	default:
+		return nil, c.offSiteLimiter, false
+	}
+}
+
+// doMirroredRequestWithContext makes a single attempt at fetching the provided URL
+// trying each mirror in turn until one of them responds without a DNS, connection
+// or server error. URLs outside the YTS API and the YTS website are fetched as is
+// once paced by the off-site rate limiter, without being failed over across mirrors.
+func (c *Client) doMirroredRequestWithContext(
+	ctx context.Context, targetURL *url.URL, header http.Header, metadata *ResponseMetadata,
+) (*http.Response, error) {
+	pool, limiter, ok := c.mirrorPoolFor(targetURL)
+	if !ok {
+		if err := limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+		return c.doRequestWithContext(ctx, targetURL, header)
+	}
+
+	var (
+		candidates = pool.candidates(time.Now())
+		response   *http.Response
+		err        error
+	)
+
+	for n, i := range candidates {
+		if wErr := limiter.wait(ctx); wErr != nil {
+			return nil, wErr
+		}
+
+		mirrorURL, mirror := pool.rewrite(targetURL, i)
+		response, err = c.doRequestWithContext(ctx, mirrorURL, header)
+		if ctx.Err() != nil {
+			return response, err
+		}
+
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) {
+			httpErr.Mirror = mirror
+		}
+
+		if !isFailoverWorthy(ctx, response, err) {
+			pool.markSuccess(i)
+			metadata.Mirror = mirror
+			return response, err
+		}
+
+		pool.markFailure(i, time.Now())
+		if n < len(candidates)-1 {
+			c.logger.Warn(
+				"mirror failed, failing over",
+				slog.String("mirror", mirror),
+				slog.Any("error", err),
+			)
+			closeResponse(response)
+		}
+	}
+
+	return response, err
+}
+
+// MirrorStatuses returns the health of every YTS API and YTS website mirror used
+// by this client, the first mirror of each list being the configured base URL.
+func (c *Client) MirrorStatuses() MirrorStatuses {
+	now := time.Now()
+	return MirrorStatuses{
+		API:  c.apiMirrors.statuses(now),
+		Site: c.siteMirrors.statuses(now),
+	}
+}
+
+// ActiveMirrors returns the base URLs of the YTS API and YTS website mirrors that
+// are currently used for making requests by this client.
+func (c *Client) ActiveMirrors() (apiBaseURL, siteURL url.URL) {
+	return c.apiMirrors.activeMirror(), c.siteMirrors.activeMirror()
+}
+
+func validateMirrors(mirrors []url.URL) error {
+	for i := range mirrors {
+		if mirrors[i].Scheme == "" || mirrors[i].Host == "" {
+			return fmt.Errorf("mirrors[%d] = %q must be an absolute URL", i, mirrors[i].String())
+		}
+	}
+	return nil
+}
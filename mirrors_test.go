@@ -0,0 +1,215 @@
+package yts_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"testing"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func TestClient_MirrorFailover(t *testing.T) {
+	const (
+		methodName  = "Client.TrendingMovies"
+		testdataDir = "trending_movies"
+	)
+
+	tests := []struct {
+		name          string
+		primaryStatus int
+		closePrimary  bool
+	}{
+		{
+			name:          "fails over to mirror when primary responds with server error",
+			primaryStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:         "fails over to mirror when primary refuses connections",
+			closePrimary: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primaryCounter := &testRequestCounter{}
+			primaryCfg := handlerConfigWithStatusCode(t, "/", tt.primaryStatus)
+			primary := createTestServer(t, primaryCfg.withCounter(primaryCounter))
+			defer primary.Close()
+			if tt.closePrimary {
+				primary.Close()
+			}
+
+			mirrorCounter := &testRequestCounter{}
+			mirrorCfg := defaultHandlerConfig(t, "/", testdataDir, "ok_response.html")
+			mirror := createTestServer(t, mirrorCfg.withCounter(mirrorCounter))
+			defer mirror.Close()
+
+			var (
+				primaryURL, _ = url.Parse(primary.URL)
+				mirrorURL, _  = url.Parse(mirror.URL)
+				clientCfg     = yts.DefaultClientConfig()
+			)
+
+			clientCfg.SiteURL = *primaryURL
+			clientCfg.SiteMirrors = []url.URL{*mirrorURL}
+			clientCfg.RetryPolicy = yts.RetryPolicy{}
+
+			var metadata []yts.ResponseMetadata
+			ctx := yts.WithResponseMetadata(context.Background(), func(m yts.ResponseMetadata) {
+				metadata = append(metadata, m)
+			})
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			for i := 0; i < 2; i++ {
+				_, err := c.TrendingMoviesWithContext(yts.WithCacheMode(ctx, yts.CacheModeBypass))
+				assertError(t, methodName, err, nil)
+			}
+
+			wantPrimaryRequests := int32(1)
+			if tt.closePrimary {
+				wantPrimaryRequests = 0
+			}
+
+			assertEqual(t, methodName, primaryCounter.requests.Load(), wantPrimaryRequests)
+			assertEqual(t, methodName, mirrorCounter.requests.Load(), int32(2))
+			assertEqual(t, methodName, metadata[0].Mirror, mirror.URL)
+			assertEqual(t, methodName, metadata[1].Mirror, mirror.URL)
+
+			_, activeSiteURL := c.ActiveMirrors()
+			assertEqual(t, "Client.ActiveMirrors", activeSiteURL, *mirrorURL)
+
+			statuses := c.MirrorStatuses()
+			wantStatuses := []yts.MirrorStatus{
+				{URL: primary.URL, Active: false, Healthy: false, ConsecutiveFailures: 1},
+				{URL: mirror.URL, Active: true, Healthy: true, ConsecutiveFailures: 0},
+			}
+			assertEqual(t, "Client.MirrorStatuses", statuses.Site, wantStatuses)
+		})
+	}
+}
+
+func TestClient_MirrorFailoverExhausted(t *testing.T) {
+	const methodName = "Client.TrendingMovies"
+
+	primary := createTestServer(t, handlerConfigWithStatusCode(t, "/", http.StatusBadGateway))
+	defer primary.Close()
+
+	mirror := createTestServer(t, handlerConfigWithStatusCode(t, "/", http.StatusServiceUnavailable))
+	defer mirror.Close()
+
+	var (
+		primaryURL, _ = url.Parse(primary.URL)
+		mirrorURL, _  = url.Parse(mirror.URL)
+		clientCfg     = yts.DefaultClientConfig()
+	)
+
+	clientCfg.SiteURL = *primaryURL
+	clientCfg.SiteMirrors = []url.URL{*mirrorURL}
+	clientCfg.RetryPolicy = yts.RetryPolicy{}
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	_, err := c.TrendingMoviesWithContext(context.Background())
+	assertError(t, methodName, err, yts.ErrUnexpectedHTTPResponseStatus)
+}
+
+func TestClient_MirrorFailoverOffSite(t *testing.T) {
+	const (
+		methodName  = "Client.AllMovieReviews"
+		testdataDir = "movie_reviews_pages"
+		movieSlug   = "oppenheimer-2023"
+		pageOnePath = "title/tt15398776/reviews"
+	)
+
+	server := createTestServer(
+		t,
+		defaultHandlerConfig(t, path.Join("movies", movieSlug), testdataDir, "movie_page.html"),
+		handlerConfigWithStatusCode(t, pageOnePath, http.StatusBadGateway),
+	)
+	defer server.Close()
+
+	mirror := createTestServer(t, handlerConfigWithStatusCode(t, "/", http.StatusServiceUnavailable))
+	defer mirror.Close()
+
+	var (
+		serverURL, _ = url.Parse(server.URL)
+		mirrorURL, _ = url.Parse(mirror.URL)
+		clientCfg    = yts.DefaultClientConfig()
+	)
+
+	clientCfg.SiteURL = *serverURL
+	clientCfg.SiteMirrors = []url.URL{*mirrorURL}
+	clientCfg.SiteRateLimit = yts.RateLimit{RequestsPerSecond: 1, Burst: 1}
+	clientCfg.RetryPolicy = yts.RetryPolicy{}
+	clientCfg.Transport = serverRoundTripper(t, server)
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	_, err := c.AllMovieReviewsWithContext(context.Background(), movieSlug)
+	assertError(t, methodName, err, yts.ErrUnexpectedHTTPResponseStatus)
+
+	for _, status := range c.MirrorStatuses().Site {
+		assertEqual(t, methodName, status.Healthy, true)
+		assertEqual(t, methodName, status.ConsecutiveFailures, 0)
+	}
+	assertEqual(t, methodName, c.RateLimitStats().Site.Waits, int64(0))
+}
+
+func TestNewClientWithConfig_Mirrors(t *testing.T) {
+	const methodName = "NewClientWithConfig"
+
+	tests := []struct {
+		name        string
+		apiMirrors  []url.URL
+		siteMirrors []url.URL
+		wantErr     error
+	}{
+		{
+			name:       "returns error for relative API mirror",
+			apiMirrors: []url.URL{{Path: "/api/v2"}},
+			wantErr:    yts.ErrInvalidClientConfig,
+		},
+		{
+			name:        "returns error for site mirror without host",
+			siteMirrors: []url.URL{{Scheme: "https"}},
+			wantErr:     yts.ErrInvalidClientConfig,
+		},
+		{
+			name:        "returns nil error for absolute mirrors",
+			apiMirrors:  []url.URL{{Scheme: "https", Host: "yts.lt", Path: "/api/v2"}},
+			siteMirrors: []url.URL{{Scheme: "https", Host: "yts.lt"}},
+			wantErr:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.APIMirrors = tt.apiMirrors
+			clientCfg.SiteMirrors = tt.siteMirrors
+			_, err := yts.NewClientWithConfig(&clientCfg)
+			assertError(t, methodName, err, tt.wantErr)
+		})
+	}
+}
+
+func TestClient_MagnetLinksTorrentBrand(t *testing.T) {
+	const brand = "YTS.MX"
+
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.SiteURL = url.URL{Scheme: "https", Host: "yts.lt"}
+	clientCfg.TorrentBrand = brand
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+
+	infoGetter := yts.MoviePartial{
+		TitleLong: "Oppenheimer (2023)",
+		Torrents:  []yts.Torrent{{Hash: "Hash0", Quality: yts.Quality720p}},
+	}
+
+	magnet := c.MagnetLinks(&infoGetter)[yts.Quality720p]
+	wantName := url.QueryEscape("Oppenheimer (2023)+[720p]+[" + brand + "]")
+	if !strings.Contains(magnet, "dn="+wantName+"&") {
+		t.Errorf("Client.MagnetLinks() = %v, want display name %v", magnet, wantName)
+	}
+}
@@ -0,0 +1,464 @@
+package yts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// A MoviePage holds a single parsed "/movies/{slug}" page of the YTS website, its
+// accessors scrape the corresponding section of the page the first time they are
+// called and return the memoized result thereafter, hence a MoviePage allows you
+// to scrape every section of a movie page while downloading it only once.
+type MoviePage struct {
+	client   *Client
+	document *goquery.Document
+	mode     ScrapeMode
+
+	idOnce sync.Once
+	id     int
+	idErr  error
+
+	directorOnce sync.Once
+	director     *MovieDirectorData
+	directorErr  error
+
+	reviewsOnce sync.Once
+	reviews     *MovieReviewsData
+	reviewsErrs []*ScrapeError
+	reviewsErr  error
+
+	siteDetailsOnce sync.Once
+	siteDetails     *MovieSiteDetailsData
+	siteDetailsErrs []*ScrapeError
+	siteDetailsErr  error
+
+	similarOnce sync.Once
+	similar     *SimilarMoviesData
+	similarErrs []*ScrapeError
+	similarErr  error
+
+	commentsMetaOnce sync.Once
+	commentsMeta     *siteMovieCommentsMeta
+	commentsMetaErr  error
+}
+
+func (c *Client) newMoviePage(document *goquery.Document, mode ScrapeMode) *MoviePage {
+	return &MoviePage{
+		client:   c,
+		document: document,
+		mode:     mode,
+	}
+}
+
+// MoviePageWithContext is the same as the MoviePage method but requires a
+// context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext call used for making the network request, and its
+// ScrapeMode, see WithScrapeMode, is used by the accessors of the MoviePage.
+func (c *Client) MoviePageWithContext(ctx context.Context, movieSlug string) (*MoviePage, error) {
+	if movieSlug == "" {
+		err := fmt.Errorf("provided movie slug cannot be an empty")
+		return nil, wrapErr(ErrValidationFailure, err)
+	}
+
+	pageURLString := fmt.Sprintf("%s/movies/%s", &c.config.SiteURL, movieSlug)
+	pageURL, _ := url.Parse(pageURLString)
+	// The sections of a movie page are scraped lazily by its accessors, the page is
+	// hence stored in the cache as soon as it has been parsed.
+	parse := func(d *goquery.Document, _ time.Time) (*goquery.Document, error) {
+		return d, nil
+	}
+
+	document, err := newDocumentRequestWithContext(ctx, c, EndpointMoviePage, pageURL, parse)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newMoviePage(document, c.scrapeModeFrom(ctx)), nil
+}
+
+// MoviePage method fetches the movie page corresponding to the provided movie slug
+// and returns it as a *MoviePage, from which its sections can be scraped.
+func (c *Client) MoviePage(movieSlug string) (*MoviePage, error) {
+	return c.MoviePageWithContext(context.Background(), movieSlug)
+}
+
+// MoviePageFromReader parses the HTML of an already downloaded movie page from the
+// provided reader, allowing movie pages to be scraped offline using the Selectors
+// and ScrapeMode of this client.
+func (c *Client) MoviePageFromReader(r io.Reader) (*MoviePage, error) {
+	document, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, wrapErr(ErrContentRetrievalFailure, err)
+	}
+
+	return c.newMoviePage(document, c.config.ScrapeMode), nil
+}
+
+// ID returns the ID of the movie in the YTS movie database.
+func (p *MoviePage) ID() (int, error) {
+	p.idOnce.Do(func() {
+		p.id, p.idErr = p.client.scrapeMovieID(p.document)
+	})
+	return p.id, p.idErr
+}
+
+// Director returns the director of the movie as shown on the movie page.
+func (p *MoviePage) Director() (*MovieDirectorResponse, error) {
+	p.directorOnce.Do(func() {
+		p.director, p.directorErr = p.client.scrapeMovieDirectorData(p.document)
+	})
+
+	if p.directorErr != nil {
+		return nil, p.directorErr
+	}
+
+	return &MovieDirectorResponse{*p.director}, nil
+}
+
+// Reviews returns the movie reviews shown on the movie page.
+func (p *MoviePage) Reviews() (*MovieReviewsResponse, error) {
+	p.reviewsOnce.Do(func() {
+		p.reviews, p.reviewsErrs, p.reviewsErr = p.client.scrapeMovieReviewsData(p.document, p.mode)
+	})
+
+	if p.reviewsErr != nil {
+		return nil, p.reviewsErr
+	}
+
+	return &MovieReviewsResponse{*p.reviews, p.reviewsErrs}, nil
+}
+
+// SiteDetails returns the details of the movie shown on the movie page, these
+// being its synopsis, ratings, top cast and the tech specs of its torrents.
+func (p *MoviePage) SiteDetails() (*MovieSiteDetailsResponse, error) {
+	p.siteDetailsOnce.Do(func() {
+		p.siteDetails, p.siteDetailsErrs, p.siteDetailsErr = p.client.scrapeMovieSiteDetailsData(
+			p.document, p.mode,
+		)
+	})
+
+	if p.siteDetailsErr != nil {
+		return nil, p.siteDetailsErr
+	}
+
+	return &MovieSiteDetailsResponse{*p.siteDetails, p.siteDetailsErrs}, nil
+}
+
+// SimilarMovies returns the movies shown in the "Similar Movies" block of the
+// movie page, which is empty when the movie page does not show this block.
+func (p *MoviePage) SimilarMovies() (*SimilarMoviesResponse, error) {
+	p.similarOnce.Do(func() {
+		p.similar, p.similarErrs, p.similarErr = p.client.scrapeSimilarMoviesData(p.document, p.mode)
+	})
+
+	if p.similarErr != nil {
+		return nil, p.similarErr
+	}
+
+	return &SimilarMoviesResponse{*p.similar, p.similarErrs}, nil
+}
+
+func (p *MoviePage) commentsMetaData() (*siteMovieCommentsMeta, error) {
+	p.commentsMetaOnce.Do(func() {
+		p.commentsMeta, p.commentsMetaErr = p.client.scrapeMovieCommentsMetaData(p.document)
+	})
+	return p.commentsMeta, p.commentsMetaErr
+}
+
+// CommentCount returns the total number of comments for the movie as shown on
+// the movie page.
+func (p *MoviePage) CommentCount() (int, error) {
+	meta, err := p.commentsMetaData()
+	if err != nil {
+		return 0, err
+	}
+	return meta.commentCount, nil
+}
+
+// CommentsWithContext is the same as the Comments method but requires a
+// context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext call used for making the network request.
+func (p *MoviePage) CommentsWithContext(ctx context.Context, page int) (*MovieCommentsResponse, error) {
+	if page < 1 {
+		err := fmt.Errorf("provided comment page must be at least 1")
+		return nil, wrapErr(ErrValidationFailure, err)
+	}
+
+	meta, err := p.commentsMetaData()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		c      = p.client
+		offset = (page - 1) * movieCommentsPerPage
+		isLast = meta.commentCount-offset <= movieCommentsPerPage
+	)
+
+	commentURLString := c.getCommentsURL(meta.movieID, offset)
+	commentURL, _ := url.Parse(commentURLString)
+	scrape := func(d *goquery.Document, postedRef time.Time) (*MovieCommentsResponse, error) {
+		comments, scrapeErrs, err := c.scrapeMovieComments(d, p.mode, postedRef)
+		if err != nil {
+			return nil, err
+		}
+
+		data := MovieCommentsData{
+			CommentsMore: !isLast,
+			Comments:     comments,
+		}
+
+		return &MovieCommentsResponse{data, scrapeErrs}, nil
+	}
+
+	return newDocumentRequestWithContext(ctx, c, EndpointMovieComments, commentURL, scrape)
+}
+
+// Comments method fetches the provided page of comments for the movie, which are
+// not part of the movie page but are served by the following endpoint instead
+// /ajax/comments/{movie_id}?offset={offset}.
+func (p *MoviePage) Comments(page int) (*MovieCommentsResponse, error) {
+	return p.CommentsWithContext(context.Background(), page)
+}
+
+// AdditionalDetailsWithContext is the same as the AdditionalDetails method but
+// requires a context.Context argument to be passed, this context is then passed
+// to the http.NewRequestWithContext call used for making the network request.
+func (p *MoviePage) AdditionalDetailsWithContext(ctx context.Context) (
+	*MovieAdditionalDetailsResponse, error,
+) {
+	var (
+		director, dErr = p.Director()
+		reviews, rErr  = p.Reviews()
+		similar, sErr  = p.SimilarMovies()
+		_, mErr        = p.commentsMetaData()
+	)
+
+	if err := errors.Join(dErr, rErr, sErr, mErr); err != nil {
+		return nil, err
+	}
+
+	comments, err := p.CommentsWithContext(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	data := MovieAdditionalDetailsData{
+		Comments:        comments.Data.Comments,
+		Director:        director.Data.Director,
+		Reviews:         reviews.Data.Reviews,
+		ReviewsMoreLink: reviews.Data.ReviewsMoreLink,
+		SimilarMovies:   similar.Data.Movies,
+	}
+
+	var scrapeErrs []*ScrapeError
+	scrapeErrs = append(scrapeErrs, reviews.ScrapeErrors...)
+	scrapeErrs = append(scrapeErrs, similar.ScrapeErrors...)
+	scrapeErrs = append(scrapeErrs, comments.ScrapeErrors...)
+
+	return &MovieAdditionalDetailsResponse{data, scrapeErrs}, nil
+}
+
+// AdditionalDetails method scrapes the director, reviews, similar movies and initial
+// comments of the movie, the latter requiring a network request.
+func (p *MoviePage) AdditionalDetails() (*MovieAdditionalDetailsResponse, error) {
+	return p.AdditionalDetailsWithContext(context.Background())
+}
+
+// appendUniqueReviews appends the reviews not already seen to dst, two reviews
+// being considered duplicates when they share their author and title.
+func appendUniqueReviews(
+	dst []SiteMovieReview, seen map[string]bool, reviews []SiteMovieReview,
+) []SiteMovieReview {
+	for _, review := range reviews {
+		key := review.Author + "\x00" + review.Title
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		dst = append(dst, review)
+	}
+	return dst
+}
+
+// MaxReviewsPages is the maximum number of pages of the full review listing linked
+// to by the ReviewsMoreLink of a movie page fetched by the ReviewsPage and
+// AllReviews methods, these pages being hosted outside the YTS website.
+const MaxReviewsPages = 50
+
+// walkReviewsPages fetches the pages of the full review listing linked to by the
+// ReviewsMoreLink of the movie page in order, passing each to visit until it
+// returns false, no pages remain or MaxReviewsPages pages have been fetched, it
+// returns the total number of reviews shown on the first page of the listing.
+func (p *MoviePage) walkReviewsPages(
+	ctx context.Context, visit func(n int, page *siteMovieReviewsPage, scrapeErrs []*ScrapeError) bool,
+) (int, error) {
+	reviews, err := p.Reviews()
+	if err != nil {
+		return 0, err
+	}
+
+	if reviews.Data.ReviewsMoreLink == "" {
+		return 0, nil
+	}
+
+	pageURL, err := url.Parse(reviews.Data.ReviewsMoreLink)
+	if err != nil {
+		err = fmt.Errorf("invalid reviews more link %q, %w", reviews.Data.ReviewsMoreLink, err)
+		return 0, wrapErr(ErrContentRetrievalFailure, err)
+	}
+
+	type scrapedReviewsPage struct {
+		*siteMovieReviewsPage
+		scrapeErrs  []*ScrapeError
+		reviewCount int
+	}
+
+	var (
+		c           = p.client
+		visited     = make(map[string]bool)
+		reviewCount int
+	)
+
+	for n := 1; n <= MaxReviewsPages && pageURL != nil && !visited[pageURL.String()]; n++ {
+		visited[pageURL.String()] = true
+		isFirst := n == 1
+		scrape := func(d *goquery.Document, _ time.Time) (*scrapedReviewsPage, error) {
+			scraped := &scrapedReviewsPage{}
+			if isFirst {
+				count, err := c.scrapeReviewCount(d)
+				if err != nil {
+					return nil, err
+				}
+				scraped.reviewCount = count
+			}
+
+			reviewsPage, scrapeErrs, err := c.scrapeMovieReviewsPageData(d, p.mode)
+			if err != nil {
+				return nil, err
+			}
+
+			scraped.siteMovieReviewsPage = reviewsPage
+			scraped.scrapeErrs = scrapeErrs
+			return scraped, nil
+		}
+
+		scraped, err := newDocumentRequestWithContext(ctx, c, EndpointMovieReviews, pageURL, scrape)
+		if err != nil {
+			return 0, err
+		}
+
+		if isFirst {
+			reviewCount = scraped.reviewCount
+		}
+
+		if !visit(n, scraped.siteMovieReviewsPage, scraped.scrapeErrs) {
+			break
+		}
+		pageURL = scraped.nextURL
+	}
+
+	return reviewCount, nil
+}
+
+// ReviewsPageWithContext is the same as the ReviewsPage method but requires a
+// context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext call used for making the network requests.
+func (p *MoviePage) ReviewsPageWithContext(ctx context.Context, page int) (
+	*MovieReviewsPageResponse, error,
+) {
+	if page < 1 || MaxReviewsPages < page {
+		err := fmt.Errorf("provided review page must be between 1 and %d", MaxReviewsPages)
+		return nil, wrapErr(ErrValidationFailure, err)
+	}
+
+	data := MovieReviewsPageData{
+		PageNumber: page,
+		Reviews:    make([]SiteMovieReview, 0),
+	}
+
+	var scrapeErrs []*ScrapeError
+	reviewCount, err := p.walkReviewsPages(
+		ctx,
+		func(n int, reviewsPage *siteMovieReviewsPage, pageScrapeErrs []*ScrapeError) bool {
+			if n < page {
+				return true
+			}
+
+			data.Reviews = appendUniqueReviews(data.Reviews, make(map[string]bool), reviewsPage.reviews)
+			data.ReviewsMore = reviewsPage.nextURL != nil
+			scrapeErrs = pageScrapeErrs
+			return false
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	data.ReviewCount = reviewCount
+	return &MovieReviewsPageResponse{data, scrapeErrs}, nil
+}
+
+// ReviewsPage method fetches the provided page of the full review listing linked
+// to by the ReviewsMoreLink of the movie page, the pages of which are linked to
+// one another, hence fetching a page requires fetching every page preceding it.
+// An empty page is returned when the provided page is past the last page, and an
+// error is returned when it is past MaxReviewsPages.
+func (p *MoviePage) ReviewsPage(page int) (*MovieReviewsPageResponse, error) {
+	return p.ReviewsPageWithContext(context.Background(), page)
+}
+
+// AllReviewsWithContext is the same as the AllReviews method but requires a
+// context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext call used for making the network requests.
+func (p *MoviePage) AllReviewsWithContext(ctx context.Context) (*AllMovieReviewsResponse, error) {
+	reviews, err := p.Reviews()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		seen        = make(map[string]bool)
+		allReviews  = appendUniqueReviews(make([]SiteMovieReview, 0), seen, reviews.Data.Reviews)
+		reviewsMore bool
+		scrapeErrs  []*ScrapeError
+	)
+
+	scrapeErrs = append(scrapeErrs, reviews.ScrapeErrors...)
+	reviewCount, err := p.walkReviewsPages(
+		ctx,
+		func(_ int, reviewsPage *siteMovieReviewsPage, pageScrapeErrs []*ScrapeError) bool {
+			allReviews = appendUniqueReviews(allReviews, seen, reviewsPage.reviews)
+			reviewsMore = reviewsPage.nextURL != nil
+			scrapeErrs = append(scrapeErrs, pageScrapeErrs...)
+			return true
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	data := AllMovieReviewsData{
+		ReviewCount: reviewCount,
+		ReviewsMore: reviewsMore,
+		Reviews:     allReviews,
+	}
+
+	return &AllMovieReviewsResponse{data, scrapeErrs}, nil
+}
+
+// AllReviews method scrapes the reviews shown on the movie page followed by every
+// page of the full review listing linked to by its ReviewsMoreLink, reviews shown
+// on more than one page are only returned once. At most MaxReviewsPages pages are
+// fetched, ReviewsMore being set when the listing has pages past the last one.
+func (p *MoviePage) AllReviews() (*AllMovieReviewsResponse, error) {
+	return p.AllReviewsWithContext(context.Background())
+}
@@ -0,0 +1,100 @@
+package yts_test
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path"
+	"testing"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func TestClient_MoviePage(t *testing.T) {
+	const (
+		methodName  = "Client.MoviePage"
+		testdataDir = "movie_additional_details/ok_response"
+		movieSlug   = "oppenheimer-2023"
+	)
+
+	counter := &testRequestCounter{}
+	handlerCfg := defaultHandlerConfig(t, "/", testdataDir, "movie_page.html")
+	server := createTestServer(t, handlerCfg.withCounter(counter))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.SiteURL = *serverURL
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	page, err := c.MoviePageWithContext(context.Background(), movieSlug)
+	assertError(t, methodName, err, nil)
+
+	id, err := page.ID()
+	assertError(t, "MoviePage.ID", err, nil)
+	assertEqual(t, "MoviePage.ID", id, 57427)
+
+	director, err := page.Director()
+	assertError(t, "MoviePage.Director", err, nil)
+	assertEqual(t, "MoviePage.Director", director.Data.Director.Name, "Christopher Nolan")
+
+	reviews, err := page.Reviews()
+	assertError(t, "MoviePage.Reviews", err, nil)
+	assertEqual(t, "MoviePage.Reviews", len(reviews.Data.Reviews), 3)
+
+	commentCount, err := page.CommentCount()
+	assertError(t, "MoviePage.CommentCount", err, nil)
+	assertEqual(t, "MoviePage.CommentCount", commentCount, 3)
+
+	assertEqual(t, methodName, counter.requests.Load(), int32(1))
+}
+
+func TestClient_MoviePageWithContextValidation(t *testing.T) {
+	const methodName = "Client.MoviePage"
+
+	c := yts.NewClient()
+	_, err := c.MoviePageWithContext(context.Background(), "")
+	assertError(t, methodName, err, yts.ErrValidationFailure)
+}
+
+func TestClient_MoviePageFromReader(t *testing.T) {
+	const methodName = "Client.MoviePageFromReader"
+
+	tests := []struct {
+		name        string
+		testdataDir string
+		wantID      int
+		wantErr     error
+	}{
+		{
+			name:        "scrapes movie page read from file",
+			testdataDir: "movie_additional_details/ok_response",
+			wantID:      57427,
+			wantErr:     nil,
+		},
+		{
+			name:        "returns error when movie ID is missing",
+			testdataDir: "movie_additional_details/missing_movie_page_id",
+			wantID:      0,
+			wantErr:     yts.ErrContentRetrievalFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := os.Open(path.Join("testdata", tt.testdataDir, "movie_page.html"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			c := yts.NewClient()
+			page, err := c.MoviePageFromReader(file)
+			assertError(t, methodName, err, nil)
+
+			id, err := page.ID()
+			assertError(t, "MoviePage.ID", err, tt.wantErr)
+			assertEqual(t, "MoviePage.ID", id, tt.wantID)
+		})
+	}
+}
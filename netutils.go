@@ -1,24 +1,161 @@
 package yts
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
 // ErrUnexpectedHTTPResponseStatus indicates that a `yts.Client` method made a
-// network call which had response code outside of the range (200-299).
+// network call which had response code outside of the range (200-299), the
+// returned error is an *HTTPError carrying further details.
 var ErrUnexpectedHTTPResponseStatus = errors.New(
 	"unexpected_http_response_status",
 )
 
+// HTTPErrorBodyLimit is the maximum number of response body bytes retained by
+// the Body field of an *HTTPError.
+const HTTPErrorBodyLimit = 1 << 10
+
+// An HTTPError is returned by `yts.Client` methods when a network call results in
+// a response with a status code outside of the range (200-299), it satisfies
+// errors.Is(err, ErrUnexpectedHTTPResponseStatus) and can be retrieved from the
+// returned error using errors.As.
+type HTTPError struct {
+	// The status code of the response.
+	StatusCode int
+
+	// The method of the request.
+	Method string
+
+	// The URL of the request, including the base URL of the mirror used.
+	URL string
+
+	// The headers of the response.
+	Header http.Header
+
+	// The first HTTPErrorBodyLimit bytes of the response body.
+	Body []byte
+
+	// The base URL of the YTS API or YTS website mirror which responded.
+	Mirror string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf(
+		"%s: %s %q received response with status code: %d",
+		ErrUnexpectedHTTPResponseStatus,
+		e.Method,
+		e.URL,
+		e.StatusCode,
+	)
+}
+
+func (e *HTTPError) Unwrap() error {
+	return ErrUnexpectedHTTPResponseStatus
+}
+
+func newHTTPError(request *http.Request, response *http.Response) *HTTPError {
+	body, _ := io.ReadAll(io.LimitReader(response.Body, HTTPErrorBodyLimit))
+	return &HTTPError{
+		StatusCode: response.StatusCode,
+		Method:     request.Method,
+		URL:        request.URL.String(),
+		Header:     response.Header,
+		Body:       body,
+	}
+}
+
+// A RoundTripperFunc is an adapter allowing the use of ordinary functions as an
+// http.RoundTripper, this is particularly useful for injecting transports when
+// testing code which uses a `yts.Client`.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(r).
+func (f RoundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// A RoundTripperMiddleware wraps the provided http.RoundTripper and returns the
+// resulting http.RoundTripper, for instance to add headers or record requests.
+type RoundTripperMiddleware func(http.RoundTripper) http.RoundTripper
+
+func newNetClient(config *ClientConfig) *http.Client {
+	netClient := &http.Client{}
+	if config.HTTPClient != nil {
+		*netClient = *config.HTTPClient
+	}
+
+	if config.Transport != nil {
+		netClient.Transport = config.Transport
+	}
+
+	if len(config.Middleware) > 0 {
+		transport := netClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+
+		for i := len(config.Middleware) - 1; i >= 0; i-- {
+			transport = config.Middleware[i](transport)
+		}
+		netClient.Transport = transport
+	}
+
+	netClient.Timeout = config.RequestTimeout
+	return netClient
+}
+
 func (c *Client) newRequestWithContext(
-	ctx context.Context, targetURL *url.URL,
+	ctx context.Context, targetURL *url.URL, header http.Header, metadata *ResponseMetadata,
+) (*http.Response, error) {
+	policy := &c.config.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		metadata.Attempts = attempt
+		response, err := c.doMirroredRequestWithContext(ctx, targetURL, header, metadata)
+		if err == nil {
+			return response, nil
+		}
+
+		if ctx.Err() != nil {
+			closeResponse(response)
+			return nil, err
+		}
+
+		wait, retry := policy.backoff(attempt, response, err)
+		closeResponse(response)
+		if !retry {
+			return nil, err
+		}
+
+		c.logger.Warn(
+			"retrying request",
+			slog.String("url", targetURL.String()),
+			slog.Int("attempt", attempt),
+			slog.Duration("wait", wait),
+			slog.Any("error", err),
+		)
+		if sErr := sleepWithContext(ctx, wait); sErr != nil {
+			return nil, sErr
+		}
+	}
+}
+
+// doRequestWithContext makes a single attempt at fetching the provided URL, the
+// response is returned alongside the error for unexpected response statuses so
+// that the caller may inspect it before closing its body. A "304 Not Modified"
+// response is only expected when conditional headers have been provided.
+func (c *Client) doRequestWithContext(
+	ctx context.Context, targetURL *url.URL, header http.Header,
 ) (*http.Response, error) {
 	targetURLString := targetURL.String()
 	request, err := http.NewRequestWithContext(ctx, "GET", targetURLString, http.NoBody)
@@ -26,48 +163,106 @@ func (c *Client) newRequestWithContext(
 		return nil, err
 	}
 
+	for key, values := range header {
+		request.Header[key] = values
+	}
+
 	response, err := c.netClient.Do(request)
 	if err != nil {
 		return nil, err
 	}
 
+	if response.StatusCode == http.StatusNotModified && len(header) > 0 {
+		return response, nil
+	}
+
 	if response.StatusCode < 200 || 299 < response.StatusCode {
-		sErr := fmt.Errorf("received response with status code: %d", response.StatusCode)
-		return nil, wrapErr(ErrUnexpectedHTTPResponseStatus, sErr)
+		return response, newHTTPError(request, response)
 	}
 
-	return response, err
+	return response, nil
 }
 
-func (c *Client) newJSONRequestWithContext(
-	ctx context.Context, targetURL *url.URL, payload any,
-) error {
-	response, err := c.newRequestWithContext(ctx, targetURL)
-	if err != nil {
-		return err
+func closeResponse(response *http.Response) {
+	if response == nil {
+		return
 	}
 
-	defer response.Body.Close()
-	decoder := json.NewDecoder(response.Body)
-	return decoder.Decode(payload)
+	const maxDrainBytes = 4 << 10
+	_, _ = io.CopyN(io.Discard, response.Body, maxDrainBytes)
+	response.Body.Close()
 }
 
-func (c *Client) newDocumentRequestWithContext(
-	ctx context.Context, targetURL *url.URL,
-) (*goquery.Document, error) {
-	response, err := c.newRequestWithContext(ctx, targetURL)
+func (c *Client) fetchBodyWithContext(
+	ctx context.Context, targetURL *url.URL, header http.Header, metadata *ResponseMetadata,
+) ([]byte, http.Header, error) {
+	response, err := c.newRequestWithContext(ctx, targetURL, header, metadata)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	metadata.StatusCode = response.StatusCode
 	defer response.Body.Close()
-	document, err := goquery.NewDocumentFromReader(response.Body)
+	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		debug.Println(err)
-		return nil, ErrContentRetrievalFailure
+		return nil, nil, err
+	}
+
+	return body, response.Header, nil
+}
+
+// newJSONRequestWithContext decodes the response of the provided URL into a new T,
+// which is then checked using the provided func, the response only being stored
+// in the cache once both have succeeded.
+func newJSONRequestWithContext[T any](
+	ctx context.Context, c *Client, endpoint Endpoint, targetURL *url.URL, check func(*T) error,
+) (*T, error) {
+	decode := func(body []byte, _ time.Time) (*T, error) {
+		payload := new(T)
+		if err := json.Unmarshal(body, payload); err != nil {
+			return nil, err
+		}
+
+		if err := check(payload); err != nil {
+			return nil, err
+		}
+
+		return payload, nil
+	}
+
+	return fetchDecodedWithContext(ctx, c, endpoint, targetURL, decode)
+}
+
+// newDocumentRequestWithContext parses the response of the provided URL as an HTML
+// document, which is then scraped using the provided func, the response only being
+// stored in the cache once scraped without error. The scrape func is also passed
+// the time at which the response was generated by the server, see
+// ResponseMetadata.Date, falling back to the current time if it is unknown.
+func newDocumentRequestWithContext[T any](
+	ctx context.Context, c *Client, endpoint Endpoint, targetURL *url.URL,
+	scrape func(d *goquery.Document, date time.Time) (T, error),
+) (T, error) {
+	decode := func(body []byte, date time.Time) (T, error) {
+		document, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+		if err != nil {
+			c.logger.Warn(
+				"failed to parse document",
+				slog.String("url", targetURL.String()),
+				slog.Any("error", err),
+			)
+			var zero T
+			return zero, ErrContentRetrievalFailure
+		}
+
+		if date.IsZero() {
+			date = time.Now()
+		}
+
+		document.Url = targetURL
+		return scrape(document, date)
 	}
 
-	return document, nil
+	return fetchDecodedWithContext(ctx, c, endpoint, targetURL, decode)
 }
 
 func (c *Client) getAPIEndpoint(path, query string) string {
@@ -0,0 +1,175 @@
+package yts_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"testing"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func fileRoundTripper(t *testing.T, dir, filename string) yts.RoundTripperFunc {
+	t.Helper()
+	return func(r *http.Request) (*http.Response, error) {
+		file, err := os.Open(path.Join("testdata", dir, filename))
+		if err != nil {
+			return nil, err
+		}
+
+		response := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       file,
+			Request:    r,
+		}
+		return response, nil
+	}
+}
+
+func TestNewClientWithConfig_Transport(t *testing.T) {
+	const methodName = "NewClientWithConfig"
+
+	tests := []struct {
+		name       string
+		httpClient *http.Client
+		transport  http.RoundTripper
+		wantErr    error
+	}{
+		{
+			name:       "returns error if both http client and transport are provided",
+			httpClient: &http.Client{},
+			transport:  http.DefaultTransport,
+			wantErr:    yts.ErrInvalidClientConfig,
+		},
+		{
+			name:       "returns nil error if http client is provided",
+			httpClient: &http.Client{},
+			wantErr:    nil,
+		},
+		{
+			name:      "returns nil error if transport is provided",
+			transport: http.DefaultTransport,
+			wantErr:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.HTTPClient = tt.httpClient
+			clientCfg.Transport = tt.transport
+			_, err := yts.NewClientWithConfig(&clientCfg)
+			assertError(t, methodName, err, tt.wantErr)
+		})
+	}
+}
+
+func TestClient_Transport(t *testing.T) {
+	const (
+		methodName  = "Client.MovieSuggestions"
+		testdataDir = "movie_suggestions"
+		movieID     = 57427
+	)
+
+	var (
+		requests    = make([]string, 0)
+		middlewares = make([]string, 0)
+	)
+
+	recorder := func(name string) yts.RoundTripperMiddleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return yts.RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				middlewares = append(middlewares, name)
+				requests = append(requests, r.URL.String())
+				return next.RoundTrip(r)
+			})
+		}
+	}
+
+	transport := fileRoundTripper(t, testdataDir, "ok_response.json")
+	tests := []struct {
+		name       string
+		httpClient *http.Client
+		transport  http.RoundTripper
+	}{
+		{
+			name:      "uses the provided transport",
+			transport: transport,
+		},
+		{
+			name:       "uses the transport of the provided http client",
+			httpClient: &http.Client{Transport: transport},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = requests[:0]
+			middlewares = middlewares[:0]
+
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.HTTPClient = tt.httpClient
+			clientCfg.Transport = tt.transport
+			clientCfg.Middleware = []yts.RoundTripperMiddleware{
+				recorder("outer"),
+				recorder("inner"),
+			}
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			got, err := c.MovieSuggestionsWithContext(context.Background(), movieID)
+			assertError(t, methodName, err, nil)
+			assertEqual(t, methodName, len(got.Data.Movies), 3)
+			assertEqual(t, methodName, middlewares, []string{"outer", "inner"})
+			assertEqual(t, methodName, requests[0], yts.DefaultAPIBaseURL+"/movie_suggestions.json?movie_id=57427")
+		})
+	}
+}
+
+func TestClient_TransportError(t *testing.T) {
+	const methodName = "Client.MovieSuggestions"
+
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.RetryPolicy = yts.RetryPolicy{}
+	clientCfg.Transport = yts.RoundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, io.ErrUnexpectedEOF
+	})
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	_, err := c.MovieSuggestionsWithContext(context.Background(), 1)
+	assertError(t, methodName, err, io.ErrUnexpectedEOF)
+}
+
+func TestClient_HTTPError(t *testing.T) {
+	const (
+		methodName = "Client.MovieDetails"
+		pattern    = "movie_details.json"
+	)
+
+	server := createTestServer(t, handlerConfigWithStatusCode(t, pattern, http.StatusNotFound))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.APIBaseURL = *serverURL
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	_, err := c.MovieDetailsWithContext(context.Background(), 1, yts.DefaultMovieDetailsFilters())
+	assertError(t, methodName, err, yts.ErrUnexpectedHTTPResponseStatus)
+
+	var httpErr *yts.HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("%s() error = %v, want *yts.HTTPError", methodName, err)
+	}
+
+	wantURL := server.URL + "/movie_details.json?movie_id=1&with_cast=true&with_images=true"
+	assertEqual(t, methodName, httpErr.StatusCode, http.StatusNotFound)
+	assertEqual(t, methodName, httpErr.Method, http.MethodGet)
+	assertEqual(t, methodName, httpErr.URL, wantURL)
+	assertEqual(t, methodName, httpErr.Mirror, server.URL)
+	assertEqual(t, methodName, string(httpErr.Body), "status_code: 404")
+}
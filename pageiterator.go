@@ -0,0 +1,157 @@
+package yts
+
+import (
+	"context"
+	"fmt"
+)
+
+// An IteratorConfig allows you to configure the behavior of the iterators walking
+// paginated content such as CommentIterator and SearchMoviesIterator, a nil or
+// zero value IteratorConfig yields every item and fetches each page only once the
+// previous page has been consumed.
+type IteratorConfig struct {
+	// The maximum number of items yielded by the iterator, zero meaning that every
+	// item is yielded.
+	MaxItems int
+
+	// This flag enables fetching the next page concurrently while the items of the
+	// current page are being consumed.
+	Prefetch bool
+}
+
+func (cfg *IteratorConfig) validate() error {
+	if cfg.MaxItems < 0 {
+		return fmt.Errorf("provided max items cannot be negative")
+	}
+	return nil
+}
+
+type pageResult[T any] struct {
+	index      int
+	items      []T
+	scrapeErrs []*ScrapeError
+
+	// The number of items on the page including the items which failed to scrape,
+	// and the number of items per page and total number of items when reported.
+	size    int
+	perPage int
+	total   int
+
+	err error
+}
+
+// pageIterator walks pages of items one item at a time, the page at an index being
+// fetched using the fetch func, until the lastPage predicate holds for a page. It
+// holds the state shared by CommentIterator and SearchMoviesIterator.
+type pageIterator[T any] struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	config   IteratorConfig
+	fetch    func(ctx context.Context, index int) pageResult[T]
+	lastPage func(result *pageResult[T]) bool
+
+	// An optional func applied to the items of each page before they are yielded.
+	filter func(items []T) []T
+
+	index      int
+	pending    chan pageResult[T]
+	page       []T
+	exhausted  bool
+	closed     bool
+	current    T
+	yielded    int
+	scrapeErrs []*ScrapeError
+	err        error
+}
+
+func newPageIterator[T any](
+	ctx context.Context,
+	config *IteratorConfig,
+	fetch func(ctx context.Context, index int) pageResult[T],
+	lastPage func(result *pageResult[T]) bool,
+) (*pageIterator[T], error) {
+	var iteratorConfig IteratorConfig
+	if config != nil {
+		iteratorConfig = *config
+	}
+
+	if err := iteratorConfig.validate(); err != nil {
+		return nil, wrapErr(ErrValidationFailure, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &pageIterator[T]{
+		ctx:      ctx,
+		cancel:   cancel,
+		config:   iteratorConfig,
+		fetch:    fetch,
+		lastPage: lastPage,
+	}, nil
+}
+
+// startFetch fetches the next page in a separate goroutine, the result of which is
+// received from the pending channel.
+func (it *pageIterator[T]) startFetch() {
+	var (
+		index   = it.index
+		pending = make(chan pageResult[T], 1)
+	)
+
+	it.index++
+	it.pending = pending
+	go func() {
+		result := it.fetch(it.ctx, index)
+		result.index = index
+		pending <- result
+	}()
+}
+
+func (it *pageIterator[T]) limitReached(n int) bool {
+	return it.config.MaxItems > 0 && n >= it.config.MaxItems
+}
+
+func (it *pageIterator[T]) next() bool {
+	if it.closed || it.err != nil || it.limitReached(it.yielded) {
+		it.close()
+		return false
+	}
+
+	for len(it.page) == 0 {
+		if it.exhausted {
+			it.close()
+			return false
+		}
+
+		if it.pending == nil {
+			it.startFetch()
+		}
+
+		result := <-it.pending
+		it.pending = nil
+		if result.err != nil {
+			it.err = result.err
+			it.close()
+			return false
+		}
+
+		it.page = result.items
+		if it.filter != nil {
+			it.page = it.filter(it.page)
+		}
+		it.scrapeErrs = append(it.scrapeErrs, result.scrapeErrs...)
+		it.exhausted = it.lastPage(&result)
+
+		if it.config.Prefetch && !it.exhausted && !it.limitReached(it.yielded+len(it.page)) {
+			it.startFetch()
+		}
+	}
+
+	it.current, it.page = it.page[0], it.page[1:]
+	it.yielded++
+	return true
+}
+
+func (it *pageIterator[T]) close() {
+	it.closed = true
+	it.cancel()
+}
@@ -0,0 +1,153 @@
+package yts
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// A RateLimit configures the token bucket used by a `yts.Client` for pacing the
+// requests made to either the YTS API, the YTS website or the hosts outside of
+// both, a zero value RateLimit disables rate limiting.
+type RateLimit struct {
+	// The rate at which tokens are added to the bucket, every request made consumes
+	// a single token, including requests made when retrying a failed request.
+	RequestsPerSecond float64
+
+	// The maximum number of tokens the bucket can hold i.e. the number of requests
+	// that can be made in a burst without waiting, a value of 0 is treated as 1.
+	Burst int
+}
+
+// DefaultOffSiteRateLimit returns the RateLimit used for the OffSiteRateLimit of
+// the ClientConfig instance returned by the DefaultClientConfig() function.
+func DefaultOffSiteRateLimit() RateLimit {
+	const (
+		defaultRequestsPerSecond = 2
+		defaultBurst             = 5
+	)
+
+	return RateLimit{
+		RequestsPerSecond: defaultRequestsPerSecond,
+		Burst:             defaultBurst,
+	}
+}
+
+func (rl *RateLimit) validate() error {
+	return validation.ValidateStruct(
+		rl,
+		validation.Field(
+			&rl.RequestsPerSecond,
+			validation.Min(0.0),
+		),
+		validation.Field(
+			&rl.Burst,
+			validation.Min(0),
+		),
+	)
+}
+
+// A RateLimiterStats instance holds metrics regarding the time spent by requests
+// waiting on a rate limiter of a `yts.Client`.
+type RateLimiterStats struct {
+	// The number of requests that had to wait before being made.
+	Waits int64 `json:"waits"`
+
+	// The total duration spent by requests waiting on the rate limiter.
+	TotalWait time.Duration `json:"total_wait"`
+
+	// The longest duration a single request has spent waiting on the rate limiter.
+	MaxWait time.Duration `json:"max_wait"`
+}
+
+// A RateLimitStats instance holds the RateLimiterStats for the YTS API, the YTS
+// website and the off-site rate limiters of a `yts.Client`, see the RateLimitStats
+// method.
+type RateLimitStats struct {
+	API     RateLimiterStats `json:"api"`
+	Site    RateLimiterStats `json:"site"`
+	OffSite RateLimiterStats `json:"off_site"`
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+	stats  RateLimiterStats
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	return &tokenBucket{limit: limit}
+}
+
+func (b *tokenBucket) burst() float64 {
+	return float64(max(b.limit.Burst, 1))
+}
+
+// reserve consumes a token from the bucket and returns the duration the caller
+// must wait before the consumed token becomes available.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.last.IsZero() {
+		b.tokens = b.burst()
+		b.last = now
+	}
+
+	elapsed := now.Sub(b.last).Seconds()
+	b.tokens = min(b.burst(), b.tokens+elapsed*b.limit.RequestsPerSecond)
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.limit.RequestsPerSecond * float64(time.Second))
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	if b.limit.RequestsPerSecond == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	delay := b.reserve(start)
+	if delay == 0 {
+		return nil
+	}
+
+	err := sleepWithContext(ctx, delay)
+	waited := time.Since(start)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err != nil {
+		b.tokens++
+	}
+
+	b.stats.Waits++
+	b.stats.TotalWait += waited
+	b.stats.MaxWait = max(b.stats.MaxWait, waited)
+	return err
+}
+
+func (b *tokenBucket) getStats() RateLimiterStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.stats
+}
+
+// RateLimitStats returns the metrics regarding the time requests made by this
+// client have spent waiting on its YTS API, YTS website and off-site rate limiters.
+func (c *Client) RateLimitStats() RateLimitStats {
+	return RateLimitStats{
+		API:     c.apiLimiter.getStats(),
+		Site:    c.siteLimiter.getStats(),
+		OffSite: c.offSiteLimiter.getStats(),
+	}
+}
@@ -0,0 +1,146 @@
+package yts_test
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"testing"
+	"time"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func TestClient_RateLimitStats(t *testing.T) {
+	const (
+		methodName  = "Client.RateLimitStats"
+		testdataDir = "search_movies"
+		pattern     = "list_movies.json"
+		requests    = 3
+	)
+
+	server := createTestServer(t, defaultHandlerConfig(t, pattern, testdataDir, "ok_response.json"))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.APIBaseURL = *serverURL
+	clientCfg.APIRateLimit = yts.RateLimit{RequestsPerSecond: 50, Burst: 1}
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	filters := yts.DefaultSearchMoviesFilters("")
+	for i := 0; i < requests; i++ {
+		if _, err := c.SearchMoviesWithContext(context.Background(), filters); err != nil {
+			t.Fatalf("%s() unexpected error = %v", methodName, err)
+		}
+	}
+
+	stats := c.RateLimitStats()
+	assertEqual(t, methodName, stats.API.Waits, int64(requests-1))
+	assertEqual(t, methodName, stats.Site, yts.RateLimiterStats{})
+	if stats.API.TotalWait < 20*time.Millisecond {
+		t.Errorf("%s() API.TotalWait = %s, want >= 20ms", methodName, stats.API.TotalWait)
+	}
+}
+
+func TestClient_RateLimitStatsOffSite(t *testing.T) {
+	const (
+		methodName  = "Client.AllMovieReviews"
+		testdataDir = "movie_reviews_pages"
+		movieSlug   = "oppenheimer-2023"
+	)
+
+	server := createTestServer(
+		t,
+		defaultHandlerConfig(t, path.Join("movies", movieSlug), testdataDir, "movie_page.html"),
+		defaultHandlerConfig(t, "title/tt15398776/reviews", testdataDir, "reviews_page_1.html"),
+		defaultHandlerConfig(t, "title/tt15398776/reviews/_ajax", testdataDir, "reviews_page_2.html"),
+	)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.SiteURL = *serverURL
+	clientCfg.Transport = serverRoundTripper(t, server)
+	clientCfg.SiteRateLimit = yts.RateLimit{RequestsPerSecond: 50, Burst: 1}
+	clientCfg.OffSiteRateLimit = yts.RateLimit{RequestsPerSecond: 50, Burst: 1}
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	_, err := c.AllMovieReviewsWithContext(context.Background(), movieSlug)
+	assertError(t, methodName, err, nil)
+
+	stats := c.RateLimitStats()
+	assertEqual(t, methodName, stats.Site, yts.RateLimiterStats{})
+	assertEqual(t, methodName, stats.OffSite.Waits, int64(1))
+}
+
+func TestClient_RateLimitContextCancellation(t *testing.T) {
+	const (
+		methodName  = "Client.SearchMovies"
+		testdataDir = "search_movies"
+		pattern     = "list_movies.json"
+	)
+
+	server := createTestServer(t, defaultHandlerConfig(t, pattern, testdataDir, "ok_response.json"))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.APIBaseURL = *serverURL
+	clientCfg.APIRateLimit = yts.RateLimit{RequestsPerSecond: 0.01, Burst: 1}
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	filters := yts.DefaultSearchMoviesFilters("")
+	_, err := c.SearchMoviesWithContext(context.Background(), filters)
+	assertError(t, methodName, err, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = c.SearchMoviesWithContext(ctx, filters)
+	assertError(t, methodName, err, context.DeadlineExceeded)
+}
+
+func TestNewClientWithConfig_RateLimit(t *testing.T) {
+	const methodName = "NewClientWithConfig"
+
+	tests := []struct {
+		name             string
+		apiRateLimit     yts.RateLimit
+		siteRateLimit    yts.RateLimit
+		offSiteRateLimit yts.RateLimit
+		wantErr          error
+	}{
+		{
+			name:         "returns error for negative API requests per second",
+			apiRateLimit: yts.RateLimit{RequestsPerSecond: -1},
+			wantErr:      yts.ErrInvalidClientConfig,
+		},
+		{
+			name:          "returns error for negative site burst",
+			siteRateLimit: yts.RateLimit{RequestsPerSecond: 1, Burst: -1},
+			wantErr:       yts.ErrInvalidClientConfig,
+		},
+		{
+			name:             "returns error for negative off-site requests per second",
+			offSiteRateLimit: yts.RateLimit{RequestsPerSecond: -1},
+			wantErr:          yts.ErrInvalidClientConfig,
+		},
+		{
+			name:          "returns nil error for valid rate limits",
+			apiRateLimit:  yts.RateLimit{RequestsPerSecond: 5, Burst: 10},
+			siteRateLimit: yts.RateLimit{RequestsPerSecond: 1, Burst: 2},
+			wantErr:       nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.APIRateLimit = tt.apiRateLimit
+			clientCfg.SiteRateLimit = tt.siteRateLimit
+			clientCfg.OffSiteRateLimit = tt.offSiteRateLimit
+			_, err := yts.NewClientWithConfig(&clientCfg)
+			assertError(t, methodName, err, tt.wantErr)
+		})
+	}
+}
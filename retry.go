@@ -0,0 +1,256 @@
+package yts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// RetryAttemptsLimit represents the maximum value that can be provided for the
+// MaxAttempts field of a RetryPolicy instance.
+const RetryAttemptsLimit = 10
+
+// A RetryableErrorClass is a bit set describing the classes of transport errors
+// for which a failed request should be retried by a `yts.Client` method.
+type RetryableErrorClass uint
+
+const (
+	// RetryOnTimeout retries requests that failed due to a network timeout, note
+	// that requests whose context was cancelled or expired are never retried.
+	RetryOnTimeout RetryableErrorClass = 1 << iota
+
+	// RetryOnConnectionError retries requests that failed because the connection
+	// was refused, reset or closed before a complete response was received.
+	RetryOnConnectionError
+
+	// RetryOnDNSError retries requests that failed because the host name of the
+	// target URL could not be resolved.
+	RetryOnDNSError
+)
+
+// A RetryPolicy allows you to configure how a `yts.Client` retries requests made
+// to both the YTS API and the YTS website, a zero value RetryPolicy disables
+// retries altogether.
+type RetryPolicy struct {
+	// The maximum number of attempts made for a single request including the first
+	// attempt, values of 0 and 1 both mean that requests are not retried.
+	MaxAttempts int
+
+	// The backoff duration used after the first failed attempt, this is doubled for
+	// every subsequent failed attempt until MaxBackoff is reached.
+	BaseBackoff time.Duration
+
+	// The upper bound for the backoff duration between two attempts, when zero the
+	// backoff duration is not bounded.
+	MaxBackoff time.Duration
+
+	// The fraction in the range [0, 1] of each backoff duration that is randomized,
+	// so that concurrent clients do not retry in lockstep.
+	Jitter float64
+
+	// The HTTP response status codes for which a request will be retried.
+	RetryableStatusCodes []int
+
+	// The classes of transport errors for which a request will be retried.
+	RetryableErrors RetryableErrorClass
+
+	// When set the "Retry-After" header of a response with a retryable status code
+	// is honored, if the requested delay exceeds MaxBackoff the request is not
+	// retried and the response error is returned instead.
+	RespectRetryAfter bool
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used by the ClientConfig instance
+// returned by the DefaultClientConfig() function.
+func DefaultRetryPolicy() RetryPolicy {
+	const (
+		defaultMaxAttempts = 3
+		defaultBaseBackoff = 500 * time.Millisecond
+		defaultMaxBackoff  = 10 * time.Second
+		defaultJitter      = 0.2
+	)
+
+	return RetryPolicy{
+		MaxAttempts: defaultMaxAttempts,
+		BaseBackoff: defaultBaseBackoff,
+		MaxBackoff:  defaultMaxBackoff,
+		Jitter:      defaultJitter,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableErrors:   RetryOnTimeout | RetryOnConnectionError,
+		RespectRetryAfter: true,
+	}
+}
+
+func (p *RetryPolicy) validate() error {
+	return validation.ValidateStruct(
+		p,
+		validation.Field(
+			&p.MaxAttempts,
+			validation.Min(0),
+			validation.Max(RetryAttemptsLimit),
+		),
+		validation.Field(
+			&p.BaseBackoff,
+			validation.Min(time.Duration(0)),
+		),
+		validation.Field(
+			&p.MaxBackoff,
+			validation.Min(time.Duration(0)),
+			validation.When(
+				p.MaxBackoff != 0,
+				validation.Min(p.BaseBackoff),
+			),
+		),
+		validation.Field(
+			&p.Jitter,
+			validation.Min(0.0),
+			validation.Max(1.0),
+		),
+		validation.Field(
+			&p.RetryableStatusCodes,
+			validation.Each(
+				validation.Min(http.StatusContinue),
+				validation.Max(599),
+			),
+		),
+	)
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) isRetryableStatus(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) isRetryableError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return p.RetryableErrors&RetryOnDNSError != 0
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return p.RetryableErrors&RetryOnTimeout != 0
+	}
+
+	isConnErr := errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+
+	return isConnErr && p.RetryableErrors&RetryOnConnectionError != 0
+}
+
+// backoff returns the duration to wait before making the next attempt, and false
+// in the event that the outcome of the provided attempt should not be retried.
+func (p *RetryPolicy) backoff(attempt int, response *http.Response, err error) (time.Duration, bool) {
+	switch {
+	case attempt >= p.maxAttempts():
+		return 0, false
+	case response != nil:
+		if !p.isRetryableStatus(response.StatusCode) {
+			return 0, false
+		}
+	case err != nil:
+		if !p.isRetryableError(err) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	wait := p.exponentialBackoff(attempt)
+	if response == nil || !p.RespectRetryAfter {
+		return wait, true
+	}
+
+	retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After"))
+	if !ok {
+		return wait, true
+	}
+
+	if p.MaxBackoff != 0 && p.MaxBackoff < retryAfter {
+		return 0, false
+	}
+
+	return max(wait, retryAfter), true
+}
+
+func (p *RetryPolicy) exponentialBackoff(attempt int) time.Duration {
+	const maxShift = 62
+	shift := min(attempt-1, maxShift)
+	wait := float64(p.BaseBackoff) * math.Pow(2, float64(shift))
+	if p.MaxBackoff != 0 {
+		wait = math.Min(wait, float64(p.MaxBackoff))
+	}
+
+	if p.Jitter > 0 {
+		//nolint:gosec // jitter does not require a cryptographically secure source.
+		delta := p.Jitter * wait * (2*rand.Float64() - 1)
+		wait += delta
+	}
+
+	return time.Duration(math.Max(wait, 0))
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(time.Until(date), 0), true
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,169 @@
+package yts_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func TestClient_RetryPolicy(t *testing.T) {
+	const methodName = "Client.SearchMovies"
+
+	fastRetryPolicy := yts.DefaultRetryPolicy()
+	fastRetryPolicy.BaseBackoff = time.Millisecond
+	fastRetryPolicy.MaxBackoff = 5 * time.Millisecond
+
+	tests := []struct {
+		name         string
+		statusCodes  []int
+		retryAfter   string
+		retryPolicy  yts.RetryPolicy
+		wantAttempts int32
+		wantErr      error
+	}{
+		{
+			name:         "returns ok response after retrying retryable status codes",
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			retryPolicy:  fastRetryPolicy,
+			wantAttempts: 3,
+		},
+		{
+			name:         "returns error once maximum attempts are exhausted",
+			statusCodes:  []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			retryPolicy:  fastRetryPolicy,
+			wantAttempts: 3,
+			wantErr:      yts.ErrUnexpectedHTTPResponseStatus,
+		},
+		{
+			name:         "returns error without retrying non retryable status codes",
+			statusCodes:  []int{http.StatusNotFound},
+			retryPolicy:  fastRetryPolicy,
+			wantAttempts: 1,
+			wantErr:      yts.ErrUnexpectedHTTPResponseStatus,
+		},
+		{
+			name:         "returns error without retrying for zero value retry policy",
+			statusCodes:  []int{http.StatusServiceUnavailable},
+			wantAttempts: 1,
+			wantErr:      yts.ErrUnexpectedHTTPResponseStatus,
+		},
+		{
+			name:         `returns error when "Retry-After" exceeds maximum backoff`,
+			statusCodes:  []int{http.StatusTooManyRequests},
+			retryAfter:   "120",
+			retryPolicy:  fastRetryPolicy,
+			wantAttempts: 1,
+			wantErr:      yts.ErrUnexpectedHTTPResponseStatus,
+		},
+		{
+			name:         `returns ok response after honoring "Retry-After"`,
+			statusCodes:  []int{http.StatusTooManyRequests},
+			retryAfter:   "0",
+			retryPolicy:  fastRetryPolicy,
+			wantAttempts: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := &testRequestCounter{}
+			handlerCfg := defaultHandlerConfig(t, "/", "search_movies", "ok_response.json")
+			handlerCfg = handlerCfg.withStatusSequence(tt.statusCodes...).withCounter(attempts)
+			if tt.retryAfter != "" {
+				handlerCfg = handlerCfg.withHeader("Retry-After", tt.retryAfter)
+			}
+
+			server := createTestServer(t, handlerCfg)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.APIBaseURL = *serverURL
+			clientCfg.RetryPolicy = tt.retryPolicy
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			filters := yts.DefaultSearchMoviesFilters("")
+			_, err := c.SearchMoviesWithContext(context.Background(), filters)
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, attempts.requests.Load(), tt.wantAttempts)
+		})
+	}
+}
+
+func TestClient_RetryPolicyContextCancellation(t *testing.T) {
+	const methodName = "Client.SearchMovies"
+	attempts := &testRequestCounter{}
+	handlerCfg := defaultHandlerConfig(t, "/", "search_movies", "ok_response.json")
+	handlerCfg = handlerCfg.withStatusSequence(http.StatusServiceUnavailable)
+	server := createTestServer(t, handlerCfg.withCounter(attempts))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.APIBaseURL = *serverURL
+	clientCfg.RetryPolicy.BaseBackoff = time.Minute
+	clientCfg.RetryPolicy.MaxBackoff = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	filters := yts.DefaultSearchMoviesFilters("")
+	_, err := c.SearchMoviesWithContext(ctx, filters)
+	assertError(t, methodName, err, context.DeadlineExceeded)
+	assertEqual(t, methodName, attempts.requests.Load(), int32(1))
+}
+
+func TestNewClientWithConfig_RetryPolicy(t *testing.T) {
+	const methodName = "NewClientWithConfig"
+
+	tests := []struct {
+		name        string
+		retryPolicy yts.RetryPolicy
+		wantErr     error
+	}{
+		{
+			name:        "returns error for negative maximum attempts",
+			retryPolicy: yts.RetryPolicy{MaxAttempts: -1},
+			wantErr:     yts.ErrInvalidClientConfig,
+		},
+		{
+			name:        "returns error for maximum attempts above limit",
+			retryPolicy: yts.RetryPolicy{MaxAttempts: yts.RetryAttemptsLimit + 1},
+			wantErr:     yts.ErrInvalidClientConfig,
+		},
+		{
+			name:        "returns error for jitter outside [0, 1]",
+			retryPolicy: yts.RetryPolicy{Jitter: 1.5},
+			wantErr:     yts.ErrInvalidClientConfig,
+		},
+		{
+			name:        "returns error for maximum backoff below base backoff",
+			retryPolicy: yts.RetryPolicy{BaseBackoff: time.Second, MaxBackoff: time.Millisecond},
+			wantErr:     yts.ErrInvalidClientConfig,
+		},
+		{
+			name:        "returns error for invalid retryable status codes",
+			retryPolicy: yts.RetryPolicy{RetryableStatusCodes: []int{42}},
+			wantErr:     yts.ErrInvalidClientConfig,
+		},
+		{
+			name:        "returns nil error for default retry policy",
+			retryPolicy: yts.DefaultRetryPolicy(),
+			wantErr:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.RetryPolicy = tt.retryPolicy
+			_, err := yts.NewClientWithConfig(&clientCfg)
+			assertError(t, methodName, err, tt.wantErr)
+		})
+	}
+}
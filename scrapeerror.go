@@ -0,0 +1,152 @@
+package yts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// A ScrapeError is returned by the `yts.Client` methods scraping the YTS website
+// when the markup of a fetched page no longer matches what this package expects,
+// it wraps ErrContentRetrievalFailure as well as the underlying scraping error.
+// When several items of a page fail to scrape, the returned error joins one
+// *ScrapeError per item, use errors.As for retrieving the first of them.
+type ScrapeError struct {
+	// The URL of the page that was being scraped.
+	URL string `json:"url"`
+
+	// The CSS selector which either matched no elements or matched the elements
+	// that failed to scrape.
+	Selector string `json:"selector"`
+
+	// The section of the page the failing item belongs to e.g. "popular" for the
+	// popular downloads on the home page or "comments[0].replies" for the replies to
+	// the first comment, empty when the whole page failed.
+	Section string `json:"section,omitempty"`
+
+	// The index of the failing item within its section, only meaningful when the
+	// Section field is set.
+	Index int `json:"index"`
+
+	// The validation errors of the scraped item keyed by field name e.g. "rating"
+	// or "genres", empty when the failure is not related to a specific field.
+	FieldErrors map[string]error `json:"-"`
+
+	// The underlying scraping error.
+	Err error `json:"-"`
+}
+
+func (e *ScrapeError) Error() string {
+	var b strings.Builder
+	b.WriteString(ErrContentRetrievalFailure.Error())
+	b.WriteString(": ")
+	if e.Section != "" {
+		fmt.Fprintf(&b, "%s, i=%d, ", e.Section, e.Index)
+	}
+
+	fmt.Fprintf(&b, "selector %q, url %q: %s", e.Selector, e.URL, e.Err)
+	return b.String()
+}
+
+// Unwrap returns both ErrContentRetrievalFailure and the underlying scraping error
+// so that either can be matched using errors.Is and errors.As.
+func (e *ScrapeError) Unwrap() []error {
+	return []error{ErrContentRetrievalFailure, e.Err}
+}
+
+func newScrapeError(d *goquery.Document, selector string, err error) *ScrapeError {
+	scrapeErr := &ScrapeError{
+		Selector:    selector,
+		FieldErrors: collectFieldErrors(err),
+		Err:         err,
+	}
+
+	if d != nil && d.Url != nil {
+		scrapeErr.URL = d.Url.String()
+	}
+
+	return scrapeErr
+}
+
+func newItemScrapeError(
+	d *goquery.Document, selector, section string, index int, err error,
+) *ScrapeError {
+	scrapeErr := newScrapeError(d, selector, err)
+	scrapeErr.Section = section
+	scrapeErr.Index = index
+	return scrapeErr
+}
+
+// collectFieldErrors gathers the ozzo-validation field errors contained in the
+// provided, possibly joined, error.
+func collectFieldErrors(err error) map[string]error {
+	fieldErrs := make(map[string]error)
+	var collect func(error)
+	collect = func(err error) {
+		switch v := err.(type) { //nolint:errorlint // walks the error tree itself
+		case validation.Errors:
+			for field, fErr := range v {
+				fieldErrs[field] = fErr
+			}
+		case interface{ Unwrap() []error }:
+			for _, e := range v.Unwrap() {
+				collect(e)
+			}
+		}
+	}
+
+	collect(err)
+	return fieldErrs
+}
+
+// A ScrapeMode controls how the `yts.Client` methods scraping the YTS website
+// handle individual items e.g. movie cards or comments that fail to scrape.
+type ScrapeMode int
+
+const (
+	// ScrapeModeStrict fails the entire method call when any item fails to scrape.
+	ScrapeModeStrict ScrapeMode = iota
+
+	// ScrapeModeLenient omits items which fail to scrape from the response and
+	// reports them in its ScrapeErrors field instead, the method call still fails
+	// when a whole section of the page is missing.
+	ScrapeModeLenient
+)
+
+func (m ScrapeMode) validate() error {
+	return validation.Validate(m, validation.In(ScrapeModeStrict, ScrapeModeLenient))
+}
+
+type scrapeModeKey struct{}
+
+// WithScrapeMode returns a copy of the provided context which causes `yts.Client`
+// methods called with it to use the provided ScrapeMode, overriding the ScrapeMode
+// of the client config.
+func WithScrapeMode(ctx context.Context, mode ScrapeMode) context.Context {
+	return context.WithValue(ctx, scrapeModeKey{}, mode)
+}
+
+func (c *Client) scrapeModeFrom(ctx context.Context) ScrapeMode {
+	if mode, ok := ctx.Value(scrapeModeKey{}).(ScrapeMode); ok {
+		return mode
+	}
+	return c.config.ScrapeMode
+}
+
+// itemFailuresErr returns the error a scrape helper should fail with for the
+// provided item failures, which is nil in lenient mode.
+func itemFailuresErr(mode ScrapeMode, itemErrs []*ScrapeError) error {
+	if mode == ScrapeModeLenient || len(itemErrs) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(itemErrs))
+	for _, itemErr := range itemErrs {
+		errs = append(errs, itemErr)
+	}
+	return errors.Join(errs...)
+}
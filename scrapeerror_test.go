@@ -0,0 +1,240 @@
+package yts_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"testing"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func TestClient_ScrapeError(t *testing.T) {
+	const pattern = "/"
+
+	tests := []struct {
+		name            string
+		methodName      string
+		testdataDir     string
+		filename        string
+		path            string
+		scrape          func(c *yts.Client) error
+		wantSelector    string
+		wantSection     string
+		wantIndex       int
+		wantFieldErrors []string
+	}{
+		{
+			name:        "reports selector and page URL when trending selector is missing",
+			methodName:  "Client.TrendingMovies",
+			testdataDir: "trending_movies",
+			filename:    "missing_selector.html",
+			path:        "/trending-movies",
+			scrape: func(c *yts.Client) error {
+				_, err := c.TrendingMoviesWithContext(context.Background())
+				return err
+			},
+			wantSelector: "div.browse-movie-wrap",
+		},
+		{
+			name:        "reports section, index and fields when trending movie is invalid",
+			methodName:  "Client.TrendingMovies",
+			testdataDir: "trending_movies",
+			filename:    "invalid_rating.html",
+			path:        "/trending-movies",
+			scrape: func(c *yts.Client) error {
+				_, err := c.TrendingMoviesWithContext(context.Background())
+				return err
+			},
+			wantSelector:    "div.browse-movie-wrap",
+			wantSection:     "trending",
+			wantIndex:       0,
+			wantFieldErrors: []string{"rating"},
+		},
+		{
+			name:        "reports section, index and fields when popular movie is invalid",
+			methodName:  "Client.HomePageContent",
+			testdataDir: "homepage_content",
+			filename:    "invalid_popular.html",
+			path:        "",
+			scrape: func(c *yts.Client) error {
+				_, err := c.HomePageContentWithContext(context.Background())
+				return err
+			},
+			wantSelector:    "div#popular-downloads div.browse-movie-wrap",
+			wantSection:     "popular",
+			wantIndex:       0,
+			wantFieldErrors: []string{"genres", "image", "link", "rating", "title", "year"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handlerCfg := defaultHandlerConfig(t, pattern, tt.testdataDir, tt.filename)
+			server := createTestServer(t, handlerCfg)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.SiteURL = *serverURL
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+
+			err := tt.scrape(c)
+			assertError(t, tt.methodName, err, yts.ErrContentRetrievalFailure)
+
+			var scrapeErr *yts.ScrapeError
+			if !errors.As(err, &scrapeErr) {
+				t.Fatalf("%s() error = %v, want *yts.ScrapeError", tt.methodName, err)
+			}
+
+			fieldErrors := make([]string, 0)
+			for field := range scrapeErr.FieldErrors {
+				fieldErrors = append(fieldErrors, field)
+			}
+			sort.Strings(fieldErrors)
+
+			wantFieldErrors := tt.wantFieldErrors
+			if wantFieldErrors == nil {
+				wantFieldErrors = []string{}
+			}
+
+			assertEqual(t, tt.methodName, scrapeErr.URL, server.URL+tt.path)
+			assertEqual(t, tt.methodName, scrapeErr.Selector, tt.wantSelector)
+			assertEqual(t, tt.methodName, scrapeErr.Section, tt.wantSection)
+			assertEqual(t, tt.methodName, scrapeErr.Index, tt.wantIndex)
+			assertEqual(t, tt.methodName, fieldErrors, wantFieldErrors)
+		})
+	}
+}
+
+func TestClient_ScrapeModeLenient(t *testing.T) {
+	const (
+		methodName  = "Client.HomePageContent"
+		testdataDir = "homepage_content"
+		pattern     = "/"
+	)
+
+	tests := []struct {
+		name           string
+		filename       string
+		configMode     yts.ScrapeMode
+		ctx            context.Context
+		wantPopular    int
+		wantScrapeErrs []string
+		wantErr        error
+	}{
+		{
+			name:           "returns partial results when lenient mode is configured",
+			filename:       "invalid_popular.html",
+			configMode:     yts.ScrapeModeLenient,
+			ctx:            context.Background(),
+			wantPopular:    0,
+			wantScrapeErrs: []string{"popular, i=0"},
+			wantErr:        nil,
+		},
+		{
+			name:           "returns partial results when lenient mode is set per call",
+			filename:       "invalid_upcoming.html",
+			configMode:     yts.ScrapeModeStrict,
+			ctx:            yts.WithScrapeMode(context.Background(), yts.ScrapeModeLenient),
+			wantPopular:    1,
+			wantScrapeErrs: []string{"upcoming, i=0"},
+			wantErr:        nil,
+		},
+		{
+			name:       "returns error when strict mode is set per call",
+			filename:   "invalid_popular.html",
+			configMode: yts.ScrapeModeLenient,
+			ctx:        yts.WithScrapeMode(context.Background(), yts.ScrapeModeStrict),
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error in lenient mode when a section is missing",
+			filename:   "missing_popular.html",
+			configMode: yts.ScrapeModeLenient,
+			ctx:        context.Background(),
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handlerCfg := defaultHandlerConfig(t, pattern, testdataDir, tt.filename)
+			server := createTestServer(t, handlerCfg)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.SiteURL = *serverURL
+			clientCfg.ScrapeMode = tt.configMode
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+
+			got, err := c.HomePageContentWithContext(tt.ctx)
+			assertError(t, methodName, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+
+			scrapeErrs := make([]string, 0)
+			for _, scrapeErr := range got.ScrapeErrors {
+				scrapeErrs = append(scrapeErrs, fmt.Sprintf("%s, i=%d", scrapeErr.Section, scrapeErr.Index))
+			}
+
+			assertEqual(t, methodName, len(got.Data.Popular), tt.wantPopular)
+			assertEqual(t, methodName, scrapeErrs, tt.wantScrapeErrs)
+		})
+	}
+}
+
+func TestNewClientWithConfig_ScrapeMode(t *testing.T) {
+	const methodName = "NewClientWithConfig"
+
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.ScrapeMode = yts.ScrapeModeLenient + 1
+	_, err := yts.NewClientWithConfig(&clientCfg)
+	assertError(t, methodName, err, yts.ErrInvalidClientConfig)
+}
+
+func TestClient_ScrapeModeLenientReplies(t *testing.T) {
+	const (
+		methodName  = "Client.MovieComments"
+		testdataDir = "movie_comments/invalid_reply"
+		movieSlug   = "oppenheimer-2023"
+	)
+
+	server := createTestServer(
+		t,
+		defaultHandlerConfig(t, "ajax/comments/57427", testdataDir, "comments.html"),
+		defaultHandlerConfig(t, path.Join("movies", movieSlug), testdataDir, "comments_count.html"),
+	)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.SiteURL = *serverURL
+	clientCfg.ScrapeMode = yts.ScrapeModeLenient
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+
+	got, err := c.MovieComments(movieSlug, 1)
+	assertError(t, methodName, err, nil)
+	if err != nil {
+		return
+	}
+
+	scrapeErrs := make([]string, 0)
+	for _, scrapeErr := range got.ScrapeErrors {
+		scrapeErrs = append(scrapeErrs, fmt.Sprintf("%s, i=%d", scrapeErr.Section, scrapeErr.Index))
+	}
+
+	commentIDs := make([]int, 0)
+	for _, comment := range yts.FlattenComments(got.Data.Comments) {
+		commentIDs = append(commentIDs, comment.ID)
+	}
+
+	assertEqual(t, methodName, scrapeErrs, []string{"comments[0].replies[0].replies, i=0"})
+	assertEqual(t, methodName, len(got.Data.Comments), 2)
+	assertEqual(t, methodName, commentIDs, []int{35774453, 35774460, 35774482, 35757878})
+}
@@ -3,57 +3,38 @@ package yts
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 )
 
-const (
-	trendingCSS = "div.browse-movie-wrap"
-	popularCSS  = "div#popular-downloads div.browse-movie-wrap"
-	latestCSS   = "div.content-dark div.home-movies div.browse-movie-wrap"
-	upcomingCSS = "div.content-dark ~ div.home-content div.browse-movie-wrap"
-	directorCSS = "div#movie-content div#movie-sub-info div#crew div.directors"
-)
-
-const (
-	movieBottomCSS   = "div.browse-movie-bottom"
-	movieLinkCSS     = "a.browse-movie-link"
-	movieYearCSS     = "div.browse-movie-year"
-	movieTitleCSS    = "a.browse-movie-title"
-	movieProgressCSS = "div.browse-movie-year progress"
-	movieGenreCSS    = "div.browse-movie-wrap h4:not([class='rating'])"
-)
-
-const (
-	directorThumbCSS = "div.list-cast a.avatar-thumb img"
-	directorNameCSS  = "div.list-cast-info a.name-cast span span"
-)
+func cleanString(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
 
-const (
-	reviewsCSS      = "div#movie-reviews div.review"
-	reviewRatingCSS = "div.review-properties span.review-rating"
-	reviewAuthorCSS = "div.review-properties span.review-author"
-	reviewsMoreCSS  = "div#movie-reviews a.more-reviews"
-)
+func validateScrapedGenres(genres []Genre) error {
+	var genreErrs error
+	for i, genre := range genres {
+		vErr := validation.Validate(genre, validateGenreRule)
+		if vErr != nil {
+			genreErrs = errors.Join(
+				genreErrs,
+				fmt.Errorf("invalid genres[%d] = %q", i, genre),
+			)
+		}
+	}
 
-const (
-	commentCSS          = "div.comment"
-	movieIDCSS          = "div#movie-info[data-movie-id]"
-	commentCountCSS     = "div#movie-comments span#comment-count"
-	commentAvatarCSS    = "div.comment a.avatar-thumb img"
-	commentLikeCountCSS = "div.comment div.comment-likes span.comment-like-count"
-	commentAuthorCSS    = "div.comment div.comment-likes + span a"
-	commentTimestampCSS = "div.comment div.comment-likes + span"
-	commentContentCSS   = "div.comment div.comment-text p"
-)
+	if genreErrs != nil {
+		return validation.Errors{"genres": genreErrs}
+	}
 
-func cleanString(s string) string {
-	return strings.Join(strings.Fields(s), " ")
+	return nil
 }
 
 // The SiteMovieBase type contains all the information required by both the
@@ -88,28 +69,17 @@ func (smb *SiteMovieBase) validateScraping() error {
 		),
 	)
 
-	var genreErrs error
-	for i, genre := range smb.Genres {
-		vErr := validation.Validate(genre, validateGenreRule)
-		if vErr != nil {
-			genreErrs = errors.Join(
-				genreErrs,
-				fmt.Errorf("genres: invalid genres[%d] = %q", i, genre),
-			)
-		}
-	}
-
-	return errors.Join(err, genreErrs)
+	return errors.Join(err, validateScrapedGenres(smb.Genres))
 }
 
-func (smb *SiteMovieBase) scrape(s *goquery.Selection) error {
+func (smb *SiteMovieBase) scrape(s *goquery.Selection, sel *Selectors) error {
 	var (
-		bottom   = s.Find(movieBottomCSS)
-		anchor   = s.Find(movieLinkCSS)
-		year     = bottom.Find(movieYearCSS).Text()
-		genreSel = s.Find(movieGenreCSS)
+		bottom   = s.Find(sel.MovieBottom)
+		anchor   = s.Find(sel.MovieLink)
+		year     = bottom.Find(sel.MovieYear).Text()
+		genreSel = s.Find(sel.MovieGenre)
 		link, _  = anchor.Attr("href")
-		image, _ = anchor.Find("img").Attr("src")
+		image, _ = anchor.Find(sel.MovieImage).Attr("src")
 	)
 
 	var yearInt int
@@ -127,7 +97,7 @@ func (smb *SiteMovieBase) scrape(s *goquery.Selection) error {
 		genres = append(genres, Genre(s.Text()))
 	})
 
-	smb.Title = bottom.Find(movieTitleCSS).Text()
+	smb.Title = bottom.Find(sel.MovieTitle).Text()
 	smb.Year = yearInt
 	smb.Link = link
 	smb.Image = image
@@ -166,14 +136,14 @@ func (sm *SiteMovie) validateScraping() error {
 	return errors.Join(bErr, mErr)
 }
 
-func (sm *SiteMovie) scrape(s *goquery.Selection) error {
+func (sm *SiteMovie) scrape(s *goquery.Selection, sel *Selectors) error {
 	var (
-		anchor = s.Find(movieLinkCSS)
-		rating = anchor.Find("h4.rating").Text()
+		anchor = s.Find(sel.MovieLink)
+		rating = anchor.Find(sel.MovieRating).Text()
 	)
 
 	sm.Rating = rating
-	_ = sm.SiteMovieBase.scrape(s)
+	_ = sm.SiteMovieBase.scrape(s, sel)
 	return sm.validateScraping()
 }
 
@@ -203,12 +173,12 @@ func (sum *SiteUpcomingMovie) validateScraping() error {
 	return errors.Join(bErr, mErr)
 }
 
-func (sum *SiteUpcomingMovie) scrape(s *goquery.Selection) error {
+func (sum *SiteUpcomingMovie) scrape(s *goquery.Selection, sel *Selectors) error {
 	const expectedYearElemLen = 2
 
 	var (
-		yearSel     = s.Find(movieYearCSS)
-		progressSel = yearSel.Find(movieProgressCSS)
+		yearSel     = s.Find(sel.MovieYear)
+		progressSel = yearSel.Find(sel.MovieProgress)
 		progress, _ = progressSel.Attr("value")
 	)
 
@@ -225,7 +195,7 @@ func (sum *SiteUpcomingMovie) scrape(s *goquery.Selection) error {
 
 	sum.Progress = progressInt
 	sum.Quality = quality
-	_ = sum.SiteMovieBase.scrape(s)
+	_ = sum.SiteMovieBase.scrape(s, sel)
 	return sum.validateScraping()
 }
 
@@ -251,10 +221,10 @@ func (smd *SiteMovieDirector) validateScraping() error {
 	)
 }
 
-func (smd *SiteMovieDirector) scrape(s *goquery.Selection) error {
+func (smd *SiteMovieDirector) scrape(s *goquery.Selection, sel *Selectors) error {
 	var (
-		nameSel     = s.Find(directorNameCSS)
-		thumbImgSel = s.Find(directorThumbCSS)
+		nameSel     = s.Find(sel.DirectorName)
+		thumbImgSel = s.Find(sel.DirectorThumb)
 	)
 
 	smd.Name = cleanString(nameSel.Text())
@@ -294,12 +264,22 @@ func (smr *SiteMovieReview) validateScraping() error {
 	)
 }
 
-func (smr *SiteMovieReview) scrape(s *goquery.Selection) error {
+func (smr *SiteMovieReview) scrape(s *goquery.Selection, sel *Selectors) error {
+	return smr.scrapeWith(s, sel.ReviewAuthor, sel.ReviewRating, sel.ReviewTitle, sel.ReviewContent)
+}
+
+func (smr *SiteMovieReview) scrapeFromReviewsPage(s *goquery.Selection, sel *Selectors) error {
+	return smr.scrapeWith(
+		s, sel.ReviewsPageAuthor, sel.ReviewsPageRating, sel.ReviewsPageTitle, sel.ReviewsPageContent,
+	)
+}
+
+func (smr *SiteMovieReview) scrapeWith(s *goquery.Selection, author, rating, title, content string) error {
 	var (
-		authorSel  = s.Find(reviewAuthorCSS)
-		ratingSel  = s.Find(reviewRatingCSS)
-		titleSel   = s.Find("h4")
-		contentSel = s.Find("article")
+		authorSel  = s.Find(author)
+		ratingSel  = s.Find(rating)
+		titleSel   = s.Find(title)
+		contentSel = s.Find(content)
 	)
 
 	smr.Author = cleanString(authorSel.Text())
@@ -312,11 +292,25 @@ func (smr *SiteMovieReview) scrape(s *goquery.Selection) error {
 // A SiteMovieComment instance contains all the visible information for a movie
 // comment as shown on a YTS movie page.
 type SiteMovieComment struct {
+	ID        int    `json:"id"`
+	ParentID  int    `json:"parent_id"`
 	Author    string `json:"author"`
 	AvatarURL string `json:"avatar_url"`
 	Timestamp string `json:"timestamp"`
 	Content   string `json:"content"`
 	LikeCount int    `json:"like_count"`
+
+	// The time at which the comment was posted parsed from its Timestamp, see the
+	// ParseCommentTimestamp function, relative timestamps being resolved against the
+	// "Date" header of the response. PostedAtPrecision is TimePrecisionNone and
+	// PostedAt is zero when the format of the Timestamp is not recognized.
+	PostedAt          time.Time     `json:"posted_at"`
+	PostedAtPrecision TimePrecision `json:"posted_at_precision"`
+
+	// The replies posted to the comment, which are themselves threaded, and the
+	// reply count shown for the comment, falling back to the number of replies.
+	Replies    []SiteMovieComment `json:"replies,omitempty"`
+	ReplyCount int                `json:"reply_count"`
 }
 
 func (smc *SiteMovieComment) validateScraping() error {
@@ -342,151 +336,553 @@ func (smc *SiteMovieComment) validateScraping() error {
 	)
 }
 
-func (smc *SiteMovieComment) scrape(s *goquery.Selection) error {
+// directReplies returns the comments nested within the provided comment which are
+// not nested within one of its replies.
+func directReplies(s *goquery.Selection, commentSelector string) *goquery.Selection {
+	return s.Find(commentSelector).FilterFunction(func(_ int, reply *goquery.Selection) bool {
+		return reply.Parent().Closest(commentSelector).IsSelection(s)
+	})
+}
+
+// A commentReplyFailure holds the error of a reply which failed to scrape, the
+// section identifying the replies of the comment the reply was posted to.
+type commentReplyFailure struct {
+	section string
+	index   int
+	err     error
+}
+
+// scrape scrapes the comment and its replies, the provided section and index
+// identifying the comment. Replies which fail to scrape are skipped and returned
+// as failures along with the failures of the replies nested within them, so that
+// the comment and its valid replies are kept.
+func (smc *SiteMovieComment) scrape(
+	s *goquery.Selection, sel *Selectors, postedRef time.Time, section string, index int,
+) ([]commentReplyFailure, error) {
+	// The fields of the comment are scraped from a copy of the comment without its
+	// replies, which would otherwise match the same selectors.
+	own := s.Clone()
+	own.Find(sel.Comment).Remove()
+
 	var (
-		avatarSel    = s.Find(commentAvatarCSS)
-		likeCountSel = s.Find(commentLikeCountCSS)
-		authorSel    = s.Find(commentAuthorCSS)
-		timestampSel = s.Find(commentTimestampCSS)
-		contentSel   = s.Find(commentContentCSS)
+		avatarSel     = own.Find(sel.CommentAvatar)
+		likeCountSel  = own.Find(sel.CommentLikeCount)
+		authorSel     = own.Find(sel.CommentAuthor)
+		timestampSel  = own.Find(sel.CommentTimestamp)
+		contentSel    = own.Find(sel.CommentContent)
+		replyCountSel = own.Find(sel.CommentReplyCount)
 	)
 
 	var (
 		timestampeNodes = timestampSel.Contents().Nodes
-		timestampStr    = timestampeNodes[len(timestampeNodes)-1].Data
+		timestampStr    string
 		likeCountStr    = cleanString(likeCountSel.Text())
+		idStr, _        = s.Attr("data-comment-id")
 	)
 
+	if len(timestampeNodes) != 0 {
+		timestampStr = timestampeNodes[len(timestampeNodes)-1].Data
+	}
+
+	smc.ID, _ = strconv.Atoi(idStr)
 	smc.Author = cleanString(authorSel.Text())
 	smc.AvatarURL, _ = avatarSel.Attr("src")
 	smc.LikeCount, _ = strconv.Atoi(likeCountStr)
 	smc.Timestamp = cleanString(timestampStr)
 	smc.Content = cleanString(contentSel.Text())
-	return smc.validateScraping()
+	smc.PostedAt, smc.PostedAtPrecision, _ = ParseCommentTimestamp(smc.Timestamp, postedRef)
+
+	if err := smc.validateScraping(); err != nil {
+		return nil, err
+	}
+
+	var (
+		repliesSection = fmt.Sprintf("%s[%d].replies", section, index)
+		replyFailures  []commentReplyFailure
+	)
+
+	directReplies(s, sel.Comment).Each(func(i int, r *goquery.Selection) {
+		reply := SiteMovieComment{ParentID: smc.ID}
+		nestedFailures, err := reply.scrape(r, sel, postedRef, repliesSection, i)
+		if err != nil {
+			failure := commentReplyFailure{section: repliesSection, index: i, err: err}
+			replyFailures = append(replyFailures, failure)
+			return
+		}
+
+		replyFailures = append(replyFailures, nestedFailures...)
+		smc.Replies = append(smc.Replies, reply)
+	})
+
+	smc.ReplyCount = len(smc.Replies)
+	if countStr := countRegex.FindString(replyCountSel.Text()); countStr != "" {
+		smc.ReplyCount, _ = strconv.Atoi(strings.ReplaceAll(countStr, ",", ""))
+	}
+
+	return replyFailures, nil
+}
+
+// FlattenComments returns the provided comments and their replies as a flat list in
+// thread order, each comment being followed by its replies, the ParentID of each
+// comment identifying its parent. The comments returned have no Replies.
+func FlattenComments(comments []SiteMovieComment) []SiteMovieComment {
+	flat := make([]SiteMovieComment, 0, len(comments))
+	for i := range comments {
+		comment := comments[i]
+		comment.Replies = nil
+		flat = append(flat, comment)
+		flat = append(flat, FlattenComments(comments[i].Replies)...)
+	}
+	return flat
+}
+
+// A SiteMovieCastMember instance contains the name, character name and thumbnail
+// image URL for a member of the top cast of a movie as shown on a YTS movie page.
+type SiteMovieCastMember struct {
+	Name          string `json:"name"`
+	CharacterName string `json:"character_name"`
+	URLSmallImage string `json:"url_small_image"`
+}
+
+func (smcm *SiteMovieCastMember) validateScraping() error {
+	return validation.ValidateStruct(
+		smcm,
+		validation.Field(
+			&smcm.Name,
+			validation.Required,
+		),
+		validation.Field(
+			&smcm.URLSmallImage,
+			is.URL,
+		),
+	)
+}
+
+func (smcm *SiteMovieCastMember) scrape(s *goquery.Selection, sel *Selectors) error {
+	var (
+		name        = cleanString(s.Find(sel.CastName).Text())
+		info        = cleanString(s.Find(sel.CastInfo).Text())
+		character   = strings.TrimSpace(strings.TrimPrefix(info, name))
+		thumbImgSel = s.Find(sel.CastThumb)
+	)
+
+	smcm.Name = name
+	smcm.CharacterName = strings.TrimSpace(strings.TrimPrefix(character, "as "))
+	smcm.URLSmallImage, _ = thumbImgSel.Attr("src")
+	return smcm.validateScraping()
+}
+
+// A SiteMovieTechSpecs instance contains the technical specifications shown on a
+// YTS movie page for a single torrent of the movie, the Runtime being in minutes.
+type SiteMovieTechSpecs struct {
+	Quality      Quality `json:"quality"`
+	Type         string  `json:"type"`
+	FileSize     string  `json:"file_size"`
+	Resolution   string  `json:"resolution"`
+	Language     string  `json:"language"`
+	MPARating    string  `json:"mpa_rating"`
+	SubtitlesURL string  `json:"subtitles_url"`
+	FrameRate    float64 `json:"frame_rate"`
+	Runtime      int     `json:"runtime"`
+	Peers        int     `json:"peers"`
+	Seeds        int     `json:"seeds"`
+}
+
+func (smts *SiteMovieTechSpecs) validateScraping() error {
+	return validation.ValidateStruct(
+		smts,
+		validation.Field(
+			&smts.Quality,
+			validation.Required,
+			validateQualityRule,
+		),
+		validation.Field(
+			&smts.Type,
+			validation.Required,
+		),
+		validation.Field(
+			&smts.FileSize,
+			validation.Required,
+		),
+		validation.Field(
+			&smts.Resolution,
+			validation.Required,
+		),
+		validation.Field(
+			&smts.SubtitlesURL,
+			is.URL,
+		),
+		validation.Field(
+			&smts.FrameRate,
+			validation.Min(0.0),
+		),
+		validation.Field(
+			&smts.Runtime,
+			validation.Min(0),
+		),
+	)
+}
+
+var (
+	techSpecRuntimeRegex = regexp.MustCompile(`^(?:(\d+) hr)?\s*(?:(\d+) min)?$`)
+	techSpecPeersRegex   = regexp.MustCompile(`^P/S (\d+) / (\d+)$`)
+)
+
+func parseTechSpecRuntime(s string) (int, error) {
+	const minutesPerHour = 60
+	match := techSpecRuntimeRegex.FindStringSubmatch(s)
+	if match == nil || s == "" {
+		return 0, fmt.Errorf("expecting runtime in %q format, got %q", "0 hr 0 min", s)
+	}
+
+	hours, _ := strconv.Atoi(match[1])
+	minutes, _ := strconv.Atoi(match[2])
+	return hours*minutesPerHour + minutes, nil
+}
+
+func (smts *SiteMovieTechSpecs) scrape(s *goquery.Selection, sel *Selectors) error {
+	const qualityTypeSep = "."
+
+	var (
+		id, _     = s.Attr("id")
+		sepIndex  = strings.LastIndex(id, qualityTypeSep)
+		parseErrs = validation.Errors{}
+	)
+
+	if sepIndex != -1 {
+		smts.Quality = Quality(id[:sepIndex])
+		smts.Type = strings.ToLower(id[sepIndex+1:])
+	}
+
+	s.Find(sel.TechSpecElement).Each(func(_ int, el *goquery.Selection) {
+		var (
+			label, _ = el.Find(sel.TechSpecLabel).Attr("title")
+			value    = cleanString(el.Text())
+			err      error
+		)
+
+		switch label {
+		case "File Size":
+			smts.FileSize = value
+		case "Resolution":
+			smts.Resolution = value
+		case "Language":
+			smts.Language = value
+		case "MPA Rating":
+			smts.MPARating = value
+		case "Subtitles":
+			smts.SubtitlesURL, _ = el.Find("a").Attr("href")
+		case "Frame Rate":
+			smts.FrameRate, err = strconv.ParseFloat(strings.TrimSuffix(value, " fps"), 64)
+			parseErrs["frame_rate"] = err
+		case "Runtime":
+			smts.Runtime, err = parseTechSpecRuntime(value)
+			parseErrs["runtime"] = err
+		case "Peers and Seeds":
+			match := techSpecPeersRegex.FindStringSubmatch(value)
+			if match == nil {
+				parseErrs["peers"] = fmt.Errorf("expecting %q format, got %q", "P/S 0 / 0", value)
+				return
+			}
+			smts.Peers, _ = strconv.Atoi(match[1])
+			smts.Seeds, _ = strconv.Atoi(match[2])
+		}
+	})
+
+	return errors.Join(parseErrs.Filter(), smts.validateScraping())
+}
+
+// A SiteMovieDetails instance contains the details of a movie as shown on its YTS
+// movie page, including its top cast and the technical specifications of each of
+// its torrents.
+type SiteMovieDetails struct {
+	Title         string                `json:"title"`
+	Year          int                   `json:"year"`
+	Genres        []Genre               `json:"genres"`
+	Synopsis      string                `json:"synopsis"`
+	Likes         int                   `json:"likes"`
+	IMDbRating    float64               `json:"imdb_rating"`
+	IMDbLink      string                `json:"imdb_link"`
+	CriticsScore  string                `json:"critics_score"`
+	AudienceScore string                `json:"audience_score"`
+	Cast          []SiteMovieCastMember `json:"cast"`
+	TechSpecs     []SiteMovieTechSpecs  `json:"tech_specs"`
+}
+
+var validateScoreRule = validation.Match(regexp.MustCompile(`^\d{1,3}%$`))
+
+func (smd *SiteMovieDetails) validateScraping() error {
+	const maxIMDbRating = 10.0
+	err := validation.ValidateStruct(
+		smd,
+		validation.Field(
+			&smd.Title,
+			validation.Required,
+		),
+		validation.Field(
+			&smd.Year,
+			validation.Required,
+		),
+		validation.Field(
+			&smd.Synopsis,
+			validation.Required,
+		),
+		validation.Field(
+			&smd.Likes,
+			validation.Min(0),
+		),
+		validation.Field(
+			&smd.IMDbRating,
+			validation.Min(0.0),
+			validation.Max(maxIMDbRating),
+		),
+		validation.Field(
+			&smd.IMDbLink,
+			is.URL,
+		),
+		validation.Field(
+			&smd.CriticsScore,
+			validateScoreRule,
+		),
+		validation.Field(
+			&smd.AudienceScore,
+			validateScoreRule,
+		),
+	)
+
+	return errors.Join(err, validateScrapedGenres(smd.Genres))
+}
+
+// scrape scrapes every detail of the movie except for the cast and tech specs,
+// which are scraped as individual items by the `yts.Client`.
+func (smd *SiteMovieDetails) scrape(s *goquery.Selection, sel *Selectors) error {
+	const genreSep = "/"
+
+	var (
+		yearStr     = cleanString(s.Find(sel.DetailsYear).Text())
+		genresStr   = cleanString(s.Find(sel.DetailsGenres).Text())
+		likesStr    = cleanString(s.Find(sel.DetailsLikes).Text())
+		ratingStr   = cleanString(s.Find(sel.DetailsIMDbRating).Text())
+		imdbLink, _ = s.Find(sel.DetailsIMDbLink).Attr("href")
+		parseErrs   = validation.Errors{}
+		genres      = make([]Genre, 0)
+		parseErr    error
+	)
+
+	for _, genre := range strings.Split(genresStr, genreSep) {
+		if genre = strings.TrimSpace(genre); genre != "" {
+			genres = append(genres, Genre(genre))
+		}
+	}
+
+	smd.Year, parseErr = strconv.Atoi(yearStr)
+	parseErrs["year"] = parseErr
+
+	if likesStr != "" {
+		smd.Likes, parseErr = strconv.Atoi(likesStr)
+		parseErrs["likes"] = parseErr
+	}
+
+	if ratingStr != "" {
+		smd.IMDbRating, parseErr = strconv.ParseFloat(ratingStr, 64)
+		parseErrs["imdb_rating"] = parseErr
+	}
+
+	smd.Title = cleanString(s.Find(sel.DetailsTitle).Text())
+	smd.Genres = genres
+	smd.Synopsis = cleanString(s.Find(sel.DetailsSynopsis).Text())
+	smd.IMDbLink = imdbLink
+	smd.CriticsScore = cleanString(s.Find(sel.DetailsCriticsScore).Text())
+	smd.AudienceScore = cleanString(s.Find(sel.DetailsAudienceScore).Text())
+	return errors.Join(parseErrs.Filter(), smd.validateScraping())
+}
+
+// A SiteSimilarMovie instance represents a movie shown in the "Similar Movies"
+// block of a YTS movie page, the block not showing the genres of these movies.
+type SiteSimilarMovie struct {
+	SiteMovieBase
+	Slug string `json:"slug"`
+}
+
+var similarMovieTitleRegex = regexp.MustCompile(`^(.*) \((\d{4})\)$`)
+
+// movieSlugFromLink extracts the movie slug from the provided link to a YTS movie
+// page, an empty string being returned for links to other pages.
+func movieSlugFromLink(link string) string {
+	const moviesPathPrefix = "/movies/"
+	parsedLink, err := url.Parse(link)
+	if err != nil || !strings.HasPrefix(parsedLink.Path, moviesPathPrefix) {
+		return ""
+	}
+	return strings.Trim(strings.TrimPrefix(parsedLink.Path, moviesPathPrefix), "/")
+}
+
+func (ssm *SiteSimilarMovie) validateScraping() error {
+	bErr := ssm.SiteMovieBase.validateScraping()
+	mErr := validation.ValidateStruct(
+		ssm,
+		validation.Field(
+			&ssm.Slug,
+			validation.Required,
+		),
+	)
+	return errors.Join(bErr, mErr)
+}
+
+func (ssm *SiteSimilarMovie) scrape(s *goquery.Selection, sel *Selectors) error {
+	var (
+		title, _ = s.Attr("title")
+		link, _  = s.Attr("href")
+		image, _ = s.Find(sel.SimilarMovieImage).Attr("src")
+	)
+
+	if match := similarMovieTitleRegex.FindStringSubmatch(title); match != nil {
+		title = match[1]
+		ssm.Year, _ = strconv.Atoi(match[2])
+	}
+
+	ssm.Title = cleanString(title)
+	ssm.Link = link
+	ssm.Image = image
+	ssm.Genres = make([]Genre, 0)
+	ssm.Slug = movieSlugFromLink(link)
+	return ssm.validateScraping()
+}
+
+func (c *Client) scrapeFailure(d *goquery.Document, selector string, err error) error {
+	scrapeErr := newScrapeError(d, selector, err)
+	c.logScrapingFailure(scrapeErr)
+	return scrapeErr
+}
+
+func (c *Client) scrapeItemFailure(
+	d *goquery.Document, selector, section string, index int, err error,
+) *ScrapeError {
+	scrapeErr := newItemScrapeError(d, selector, section, index, err)
+	c.logScrapingFailure(scrapeErr)
+	return scrapeErr
 }
 
 func (c *Client) scrapeMovieID(d *goquery.Document) (int, error) {
+	sel := c.selectors.load()
+
 	var (
-		movieIDSel         = d.Find(movieIDCSS)
+		movieIDSel         = d.Find(sel.MovieID)
 		movieIDStr, exists = movieIDSel.Attr("data-movie-id")
 	)
 
 	if !exists {
 		err := fmt.Errorf(`"data-movie-id" attr doesn't exist`)
-		debug.Println(err)
-		return 0, err
+		return 0, c.scrapeFailure(d, sel.MovieID, err)
 	}
 
 	movieID, err := strconv.Atoi(movieIDStr)
 	if err != nil {
-		sErr := fmt.Errorf("failed to convert movieID, %w", err)
-		debug.Println(sErr)
-		return 0, sErr
+		err = fmt.Errorf("failed to convert movieID, %w", err)
+		return 0, c.scrapeFailure(d, sel.MovieID, err)
 	}
 
 	return movieID, nil
 }
 
-func (c *Client) scrapeTrendingMoviesData(d *goquery.Document) (*TrendingMoviesData, error) {
-	selection := d.Find(trendingCSS)
+func (c *Client) scrapeTrendingMoviesData(d *goquery.Document, mode ScrapeMode) (
+	*TrendingMoviesData, []*ScrapeError, error,
+) {
+	sel := c.selectors.load()
+
+	selection := d.Find(sel.Trending)
 	if selection.Length() == 0 {
-		err := fmt.Errorf("no elements found for %q", trendingCSS)
-		debug.Println(err)
-		return nil, err
+		err := fmt.Errorf("no elements found for %q", sel.Trending)
+		return nil, nil, c.scrapeFailure(d, sel.Trending, err)
 	}
 
 	var (
 		trendingMovies = make([]SiteMovie, 0)
-		scrapingErrs   = make([]error, 0)
+		itemErrs       []*ScrapeError
 	)
 
 	selection.Each(func(i int, s *goquery.Selection) {
 		siteMovie := SiteMovie{}
-		err := siteMovie.scrape(s)
-		if err != nil {
-			err = fmt.Errorf("trending, i=%d, %w", i, err)
+		if err := siteMovie.scrape(s, sel); err != nil {
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.Trending, "trending", i, err))
+			return
 		}
 
 		trendingMovies = append(trendingMovies, siteMovie)
-		scrapingErrs = append(scrapingErrs, err)
 	})
 
-	if err := errors.Join(scrapingErrs...); err != nil {
-		debug.Println(err)
-		return nil, err
+	if err := itemFailuresErr(mode, itemErrs); err != nil {
+		return nil, nil, err
 	}
 
-	return &TrendingMoviesData{trendingMovies}, nil
+	return &TrendingMoviesData{trendingMovies}, itemErrs, nil
 }
 
-func (c *Client) scrapeHomePageContentData(d *goquery.Document) (*HomePageContentData, error) {
+func (c *Client) scrapeHomePageContentData(d *goquery.Document, mode ScrapeMode) (
+	*HomePageContentData, []*ScrapeError, error,
+) {
+	sel := c.selectors.load()
+
 	var (
-		popDownloadSel   = d.Find(popularCSS)
-		latestTorrentSel = d.Find(latestCSS)
-		upcomingMovieSel = d.Find(upcomingCSS)
+		popDownloadSel   = d.Find(sel.Popular)
+		latestTorrentSel = d.Find(sel.Latest)
+		upcomingMovieSel = d.Find(sel.Upcoming)
 	)
 
 	if popDownloadSel.Length() == 0 {
-		err := fmt.Errorf("no elements found for %q", popularCSS)
-		debug.Println(err)
-		return nil, err
+		err := fmt.Errorf("no elements found for %q", sel.Popular)
+		return nil, nil, c.scrapeFailure(d, sel.Popular, err)
 	}
 
 	if latestTorrentSel.Length() == 0 {
-		err := fmt.Errorf("no elements found for %q", latestCSS)
-		debug.Println(err)
-		return nil, err
+		err := fmt.Errorf("no elements found for %q", sel.Latest)
+		return nil, nil, c.scrapeFailure(d, sel.Latest, err)
 	}
 
 	if upcomingMovieSel.Length() == 0 {
-		err := fmt.Errorf("no elements found for %q", upcomingCSS)
-		debug.Println(err)
-		return nil, err
+		err := fmt.Errorf("no elements found for %q", sel.Upcoming)
+		return nil, nil, c.scrapeFailure(d, sel.Upcoming, err)
 	}
 
 	var (
 		popDownloads   = make([]SiteMovie, 0)
 		latestTorrents = make([]SiteMovie, 0)
 		upcomingMovies = make([]SiteUpcomingMovie, 0)
-		scrapingErrs   = make([]error, 0)
+		itemErrs       []*ScrapeError
 	)
 
 	popDownloadSel.Each(func(i int, s *goquery.Selection) {
 		siteMovie := SiteMovie{}
-		err := siteMovie.scrape(s)
-		if err != nil {
-			err = fmt.Errorf("popular, i=%d, %w", i, err)
+		if err := siteMovie.scrape(s, sel); err != nil {
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.Popular, "popular", i, err))
+			return
 		}
 
 		popDownloads = append(popDownloads, siteMovie)
-		scrapingErrs = append(scrapingErrs, err)
 	})
 
 	latestTorrentSel.Each(func(i int, s *goquery.Selection) {
 		siteMovie := SiteMovie{}
-		err := siteMovie.scrape(s)
-		if err != nil {
-			err = fmt.Errorf("latest, i=%d, %w", i, err)
+		if err := siteMovie.scrape(s, sel); err != nil {
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.Latest, "latest", i, err))
+			return
 		}
 
 		latestTorrents = append(latestTorrents, siteMovie)
-		scrapingErrs = append(scrapingErrs, err)
 	})
 
 	upcomingMovieSel.Each(func(i int, s *goquery.Selection) {
 		upcomingMovie := SiteUpcomingMovie{}
-		err := upcomingMovie.scrape(s)
-		if err != nil {
-			err = fmt.Errorf("upcoming, i=%d, %w", i, err)
+		if err := upcomingMovie.scrape(s, sel); err != nil {
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.Upcoming, "upcoming", i, err))
+			return
 		}
 
 		upcomingMovies = append(upcomingMovies, upcomingMovie)
-		scrapingErrs = append(scrapingErrs, err)
 	})
 
-	if err := errors.Join(scrapingErrs...); err != nil {
-		debug.Println(err)
-		return nil, err
+	if err := itemFailuresErr(mode, itemErrs); err != nil {
+		return nil, nil, err
 	}
 
 	response := &HomePageContentData{
@@ -495,73 +891,157 @@ func (c *Client) scrapeHomePageContentData(d *goquery.Document) (*HomePageConten
 		Upcoming: upcomingMovies,
 	}
 
-	return response, nil
+	return response, itemErrs, nil
 }
 
 func (c *Client) scrapeMovieDirectorData(d *goquery.Document) (*MovieDirectorData, error) {
-	directorSel := d.Find(directorCSS)
+	sel := c.selectors.load()
+
+	directorSel := d.Find(sel.Director)
 	if directorSel.Length() == 0 {
-		err := fmt.Errorf("no elements found for %q", directorCSS)
-		debug.Println(err)
-		return nil, err
+		err := fmt.Errorf("no elements found for %q", sel.Director)
+		return nil, c.scrapeFailure(d, sel.Director, err)
 	}
 
 	director := &SiteMovieDirector{}
-	if err := director.scrape(directorSel); err != nil {
-		debug.Println(err)
-		return nil, err
+	if err := director.scrape(directorSel, sel); err != nil {
+		return nil, c.scrapeFailure(d, sel.Director, err)
 	}
 
 	return &MovieDirectorData{*director}, nil
 }
 
-func (c *Client) scrapeMovieReviewsData(d *goquery.Document) (*MovieReviewsData, error) {
-	reviewsSel := d.Find(reviewsCSS)
+func (c *Client) scrapeMovieReviewsData(d *goquery.Document, mode ScrapeMode) (
+	*MovieReviewsData, []*ScrapeError, error,
+) {
+	sel := c.selectors.load()
+
+	reviewsSel := d.Find(sel.Reviews)
 	if reviewsSel.Length() == 0 {
-		err := fmt.Errorf("no elements found for %q", reviewsCSS)
-		debug.Println(err)
-		return nil, err
+		err := fmt.Errorf("no elements found for %q", sel.Reviews)
+		return nil, nil, c.scrapeFailure(d, sel.Reviews, err)
 	}
 
-	reviewsMoreSel := d.Find(reviewsMoreCSS)
+	reviewsMoreSel := d.Find(sel.ReviewsMore)
 	if reviewsMoreSel.Length() == 0 {
-		err := fmt.Errorf("no elements found for %q", reviewsMoreCSS)
-		debug.Println(err)
-		return nil, err
+		err := fmt.Errorf("no elements found for %q", sel.ReviewsMore)
+		return nil, nil, c.scrapeFailure(d, sel.ReviewsMore, err)
 	}
 
 	reviewsMoreURL, _ := reviewsMoreSel.Attr("href")
-	if err := validation.Validate(reviewsMoreURL, is.URL); err != nil {
-		err := fmt.Errorf(`invalid "href" found for %q`, reviewsMoreCSS)
-		debug.Println(err)
-		return nil, err
+	if err := validation.Validate(reviewsMoreURL, validation.Required, is.URL); err != nil {
+		err = fmt.Errorf(`invalid "href" found for %q, %w`, sel.ReviewsMore, err)
+		return nil, nil, c.scrapeFailure(d, sel.ReviewsMore, err)
 	}
 
 	var (
 		movieReviews = make([]SiteMovieReview, 0)
-		scrapingErrs = make([]error, 0)
+		itemErrs     []*ScrapeError
 	)
 
 	reviewsSel.Each(func(i int, s *goquery.Selection) {
 		movieReview := SiteMovieReview{}
-		err := movieReview.scrape(s)
-		if err != nil {
-			err = fmt.Errorf("reviews, i=%d, %w", i, err)
+		if err := movieReview.scrape(s, sel); err != nil {
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.Reviews, "reviews", i, err))
+			return
 		}
 
 		movieReviews = append(movieReviews, movieReview)
-		scrapingErrs = append(scrapingErrs, err)
 	})
 
-	if err := errors.Join(scrapingErrs...); err != nil {
-		debug.Println(err)
-		return nil, err
+	if err := itemFailuresErr(mode, itemErrs); err != nil {
+		return nil, nil, err
 	}
 
 	return &MovieReviewsData{
 		Reviews:         movieReviews,
 		ReviewsMoreLink: reviewsMoreURL,
-	}, nil
+	}, itemErrs, nil
+}
+
+var countRegex = regexp.MustCompile(`\d[\d,]*`)
+
+func (c *Client) scrapeReviewCount(d *goquery.Document) (int, error) {
+	sel := c.selectors.load()
+
+	countSel := d.Find(sel.ReviewsPageCount)
+	if countSel.Length() == 0 {
+		err := fmt.Errorf("no elements found for %q", sel.ReviewsPageCount)
+		return 0, c.scrapeFailure(d, sel.ReviewsPageCount, err)
+	}
+
+	countStr := countRegex.FindString(countSel.First().Text())
+	reviewCount, err := strconv.Atoi(strings.ReplaceAll(countStr, ",", ""))
+	if err != nil {
+		err = fmt.Errorf("failed to convert review count, %w", err)
+		return 0, c.scrapeFailure(d, sel.ReviewsPageCount, err)
+	}
+
+	return reviewCount, nil
+}
+
+type siteMovieReviewsPage struct {
+	reviews []SiteMovieReview
+	nextURL *url.URL
+}
+
+// scrapeReviewsPageNextURL resolves the URL of the next page of a review listing,
+// the element matched providing it either in its "href" attribute, or as in the
+// case of IMDb in its "data-ajaxurl" and "data-key" attributes.
+func scrapeReviewsPageNextURL(d *goquery.Document, s *goquery.Selection) *url.URL {
+	var nextURLString string
+	if href, ok := s.Attr("href"); ok {
+		nextURLString = href
+	} else if ajaxURL, ok := s.Attr("data-ajaxurl"); ok {
+		key, _ := s.Attr("data-key")
+		if key == "" {
+			return nil
+		}
+		nextURLString = fmt.Sprintf("%s?%s", ajaxURL, url.Values{"paginationKey": []string{key}}.Encode())
+	}
+
+	nextURL, err := url.Parse(nextURLString)
+	if nextURLString == "" || err != nil {
+		return nil
+	}
+
+	if d.Url != nil {
+		nextURL = d.Url.ResolveReference(nextURL)
+	}
+
+	return nextURL
+}
+
+func (c *Client) scrapeMovieReviewsPageData(d *goquery.Document, mode ScrapeMode) (
+	*siteMovieReviewsPage, []*ScrapeError, error,
+) {
+	sel := c.selectors.load()
+
+	var (
+		movieReviews = make([]SiteMovieReview, 0)
+		itemErrs     []*ScrapeError
+	)
+
+	d.Find(sel.ReviewsPageReview).Each(func(i int, s *goquery.Selection) {
+		movieReview := SiteMovieReview{}
+		if err := movieReview.scrapeFromReviewsPage(s, sel); err != nil {
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.ReviewsPageReview, "reviews_page", i, err))
+			return
+		}
+
+		movieReviews = append(movieReviews, movieReview)
+	})
+
+	if err := itemFailuresErr(mode, itemErrs); err != nil {
+		return nil, nil, err
+	}
+
+	reviewsPage := &siteMovieReviewsPage{reviews: movieReviews}
+	if moreSel := d.Find(sel.ReviewsPageMore); moreSel.Length() != 0 {
+		reviewsPage.nextURL = scrapeReviewsPageNextURL(d, moreSel.First())
+	}
+
+	return reviewsPage, itemErrs, nil
 }
 
 type siteMovieCommentsMeta struct {
@@ -570,25 +1050,23 @@ type siteMovieCommentsMeta struct {
 }
 
 func (c *Client) scrapeMovieCommentsMetaData(d *goquery.Document) (*siteMovieCommentsMeta, error) {
-	commentCountSel := d.Find(commentCountCSS)
+	sel := c.selectors.load()
+
+	commentCountSel := d.Find(sel.CommentCount)
 	if commentCountSel.Length() == 0 {
-		err := fmt.Errorf("no elements found for %q", commentCountCSS)
-		debug.Println(err)
-		return nil, err
+		err := fmt.Errorf("no elements found for %q", sel.CommentCount)
+		return nil, c.scrapeFailure(d, sel.CommentCount, err)
 	}
 
 	commentCountText := cleanString(commentCountSel.Text())
 	commentCount, err := strconv.Atoi(commentCountText)
 	if err != nil {
-		sErr := fmt.Errorf("failed to convert comment count, %w", err)
-		debug.Println(sErr)
-		return nil, err
+		err = fmt.Errorf("failed to convert comment count, %w", err)
+		return nil, c.scrapeFailure(d, sel.CommentCount, err)
 	}
 
 	movieID, err := c.scrapeMovieID(d)
 	if err != nil {
-		sErr := fmt.Errorf("failed to convert movieID, %w", err)
-		debug.Println(sErr)
 		return nil, err
 	}
 
@@ -598,32 +1076,194 @@ func (c *Client) scrapeMovieCommentsMetaData(d *goquery.Document) (*siteMovieCom
 	}, nil
 }
 
-func (c *Client) scrapeMovieComments(d *goquery.Document) ([]SiteMovieComment, error) {
-	commentSel := d.Find(commentCSS)
+func (c *Client) scrapeMovieComments(d *goquery.Document, mode ScrapeMode, postedRef time.Time) (
+	[]SiteMovieComment, []*ScrapeError, error,
+) {
+	sel := c.selectors.load()
+
+	commentSel := d.Find(sel.Comment).FilterFunction(func(_ int, s *goquery.Selection) bool {
+		return s.Parent().Closest(sel.Comment).Length() == 0
+	})
 	if commentSel.Length() == 0 {
-		return []SiteMovieComment{}, nil
+		return []SiteMovieComment{}, nil, nil
 	}
 
 	var (
 		movieComments = make([]SiteMovieComment, 0)
-		scrapingErrs  = make([]error, 0)
+		itemErrs      []*ScrapeError
 	)
 
 	commentSel.Each(func(i int, s *goquery.Selection) {
 		movieComment := SiteMovieComment{}
-		err := movieComment.scrape(s)
+		replyFailures, err := movieComment.scrape(s, sel, postedRef, "comments", i)
 		if err != nil {
-			err = fmt.Errorf("comments, i=%d, %w", i, err)
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.Comment, "comments", i, err))
+			return
 		}
 
+		for _, failure := range replyFailures {
+			replyErr := c.scrapeItemFailure(d, sel.Comment, failure.section, failure.index, failure.err)
+			itemErrs = append(itemErrs, replyErr)
+		}
 		movieComments = append(movieComments, movieComment)
-		scrapingErrs = append(scrapingErrs, err)
 	})
 
-	if err := errors.Join(scrapingErrs...); err != nil {
-		debug.Println(err)
-		return nil, err
+	if err := itemFailuresErr(mode, itemErrs); err != nil {
+		return nil, nil, err
+	}
+
+	return movieComments, itemErrs, nil
+}
+
+func (c *Client) scrapeMovieSiteDetailsData(d *goquery.Document, mode ScrapeMode) (
+	*MovieSiteDetailsData, []*ScrapeError, error,
+) {
+	sel := c.selectors.load()
+
+	detailsSel := d.Find(sel.Details)
+	if detailsSel.Length() == 0 {
+		err := fmt.Errorf("no elements found for %q", sel.Details)
+		return nil, nil, c.scrapeFailure(d, sel.Details, err)
+	}
+
+	details := SiteMovieDetails{
+		Cast:      make([]SiteMovieCastMember, 0),
+		TechSpecs: make([]SiteMovieTechSpecs, 0),
+	}
+
+	if err := details.scrape(detailsSel, sel); err != nil {
+		return nil, nil, c.scrapeFailure(d, sel.Details, err)
+	}
+
+	var itemErrs []*ScrapeError
+	detailsSel.Find(sel.DetailsCast).Each(func(i int, s *goquery.Selection) {
+		castMember := SiteMovieCastMember{}
+		if err := castMember.scrape(s, sel); err != nil {
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.DetailsCast, "cast", i, err))
+			return
+		}
+
+		details.Cast = append(details.Cast, castMember)
+	})
+
+	detailsSel.Find(sel.DetailsTechSpecs).Each(func(i int, s *goquery.Selection) {
+		techSpecs := SiteMovieTechSpecs{}
+		if err := techSpecs.scrape(s, sel); err != nil {
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.DetailsTechSpecs, "tech_specs", i, err))
+			return
+		}
+
+		details.TechSpecs = append(details.TechSpecs, techSpecs)
+	})
+
+	if err := itemFailuresErr(mode, itemErrs); err != nil {
+		return nil, nil, err
+	}
+
+	return &MovieSiteDetailsData{details}, itemErrs, nil
+}
+
+func (c *Client) scrapeSimilarMoviesData(d *goquery.Document, mode ScrapeMode) (
+	*SimilarMoviesData, []*ScrapeError, error,
+) {
+	sel := c.selectors.load()
+
+	var (
+		similarMovies = make([]SiteSimilarMovie, 0)
+		itemErrs      []*ScrapeError
+	)
+
+	// The "Similar Movies" block is not shown for every movie, its absence simply
+	// yields no similar movies.
+	d.Find(sel.SimilarMovies).Each(func(i int, s *goquery.Selection) {
+		similarMovie := SiteSimilarMovie{}
+		if err := similarMovie.scrape(s, sel); err != nil {
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.SimilarMovies, "similar", i, err))
+			return
+		}
+
+		similarMovies = append(similarMovies, similarMovie)
+	})
+
+	if err := itemFailuresErr(mode, itemErrs); err != nil {
+		return nil, nil, err
+	}
+
+	return &SimilarMoviesData{similarMovies}, itemErrs, nil
+}
+
+func pageFromLink(link string) int {
+	linkURL, err := url.Parse(link)
+	if err != nil {
+		return 0
+	}
+
+	page, _ := strconv.Atoi(linkURL.Query().Get("page"))
+	return page
+}
+
+func (c *Client) scrapeBrowseMoviesData(d *goquery.Document, mode ScrapeMode) (
+	*BrowseMoviesData, []*ScrapeError, error,
+) {
+	sel := c.selectors.load()
+
+	countSel := d.Find(sel.BrowseMovieCount)
+	if countSel.Length() == 0 {
+		err := fmt.Errorf("no elements found for %q", sel.BrowseMovieCount)
+		return nil, nil, c.scrapeFailure(d, sel.BrowseMovieCount, err)
+	}
+
+	countStr := strings.ReplaceAll(cleanString(countSel.First().Text()), ",", "")
+	movieCount, err := strconv.Atoi(countStr)
+	if err != nil {
+		err = fmt.Errorf("failed to convert movie count, %w", err)
+		return nil, nil, c.scrapeFailure(d, sel.BrowseMovieCount, err)
+	}
+
+	pageNumber := 1
+	if currentSel := d.Find(sel.BrowseCurrentPage); currentSel.Length() != 0 {
+		pageNumber, err = strconv.Atoi(cleanString(currentSel.First().Text()))
+		if err != nil {
+			err = fmt.Errorf("failed to convert current page, %w", err)
+			return nil, nil, c.scrapeFailure(d, sel.BrowseCurrentPage, err)
+		}
+	}
+
+	pageCount := pageNumber
+	d.Find(sel.BrowsePagination).Each(func(_ int, s *goquery.Selection) {
+		link, _ := s.Attr("href")
+		pageCount = max(pageCount, pageFromLink(link))
+	})
+
+	if movieCount == 0 {
+		pageCount = 0
+	}
+
+	var (
+		browseMovies = make([]SiteMovie, 0)
+		itemErrs     []*ScrapeError
+	)
+
+	d.Find(sel.BrowseMovies).Each(func(i int, s *goquery.Selection) {
+		siteMovie := SiteMovie{}
+		if err := siteMovie.scrape(s, sel); err != nil {
+			itemErrs = append(itemErrs, c.scrapeItemFailure(d, sel.BrowseMovies, "browse", i, err))
+			return
+		}
+
+		browseMovies = append(browseMovies, siteMovie)
+	})
+
+	if err := itemFailuresErr(mode, itemErrs); err != nil {
+		return nil, nil, err
+	}
+
+	data := &BrowseMoviesData{
+		MovieCount: movieCount,
+		PageNumber: pageNumber,
+		PageCount:  pageCount,
+		Movies:     browseMovies,
 	}
 
-	return movieComments, nil
+	return data, itemErrs, nil
 }
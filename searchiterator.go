@@ -0,0 +1,141 @@
+package yts
+
+import (
+	"context"
+	"fmt"
+)
+
+// A SearchMoviesIterator walks every page of results of the "/api/v2/list_movies.json"
+// endpoint for the provided filters, starting from the page of the filters, and
+// yields one movie at a time. Since movies may be added to the catalog while paging,
+// movies already yielded on a previous page are skipped. Use it as shown below and
+// call Close when you stop iterating early.
+//
+//	it, err := client.SearchMoviesAll(yts.DefaultSearchMoviesFilters("nolan"), nil)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//
+//	for it.Next() {
+//		fmt.Println(it.Movie().Title)
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// A SearchMoviesIterator is not safe for concurrent use.
+type SearchMoviesIterator struct {
+	client    *Client
+	filters   SearchMoviesFilters
+	startPage int
+	seen      map[int]struct{}
+	pages     *pageIterator[Movie]
+}
+
+// SearchMoviesAllWithContext is the same as the SearchMoviesAll method but requires
+// a context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext calls used for making the network requests.
+func (c *Client) SearchMoviesAllWithContext(
+	ctx context.Context, filters *SearchMoviesFilters, config *IteratorConfig,
+) (*SearchMoviesIterator, error) {
+	if filters == nil {
+		err := fmt.Errorf("provided filters cannot be nil")
+		return nil, wrapErr(ErrFilterValidationFailure, err)
+	}
+
+	if err := filters.validateFilters(); err != nil {
+		return nil, wrapErr(ErrFilterValidationFailure, err)
+	}
+
+	// A zero page is omitted from the request and the API then returns the first
+	// page, the iterator starts from the first page in that case as well.
+	it := &SearchMoviesIterator{
+		client:    c,
+		filters:   *filters,
+		startPage: max(filters.Page, 1),
+		seen:      make(map[int]struct{}),
+	}
+
+	pages, err := newPageIterator(ctx, config, it.fetchPage, it.isLastPage)
+	if err != nil {
+		return nil, err
+	}
+
+	pages.filter = it.unseenMovies
+	it.pages = pages
+	return it, nil
+}
+
+// SearchMoviesAll method returns a *SearchMoviesIterator over every movie matching
+// the provided search filters, fetching the pages of the "/api/v2/list_movies.json"
+// endpoint as needed. The provided filter values are validated internally and an
+// error is returned in the event validation fails.
+func (c *Client) SearchMoviesAll(filters *SearchMoviesFilters, config *IteratorConfig) (
+	*SearchMoviesIterator, error,
+) {
+	return c.SearchMoviesAllWithContext(context.Background(), filters, config)
+}
+
+func (it *SearchMoviesIterator) fetchPage(ctx context.Context, index int) pageResult[Movie] {
+	filters := it.filters
+	filters.Page = it.startPage + index
+
+	response, err := it.client.SearchMoviesWithContext(ctx, &filters)
+	if err != nil {
+		return pageResult[Movie]{err: err}
+	}
+
+	return pageResult[Movie]{
+		items:   response.Data.Movies,
+		size:    len(response.Data.Movies),
+		perPage: response.Data.Limit,
+		total:   response.Data.MovieCount,
+	}
+}
+
+// isLastPage reports whether the provided page of results is the last one, based
+// on the movie count and page limit reported by the API.
+func (it *SearchMoviesIterator) isLastPage(result *pageResult[Movie]) bool {
+	pageNumber := it.startPage + result.index
+	return result.size == 0 ||
+		result.perPage <= 0 ||
+		pageNumber*result.perPage >= result.total
+}
+
+// unseenMovies returns the provided movies which have not been yielded by the
+// iterator so far, marking them as seen.
+func (it *SearchMoviesIterator) unseenMovies(movies []Movie) []Movie {
+	unseen := make([]Movie, 0, len(movies))
+	for _, movie := range movies {
+		if _, ok := it.seen[movie.ID]; ok {
+			continue
+		}
+		it.seen[movie.ID] = struct{}{}
+		unseen = append(unseen, movie)
+	}
+	return unseen
+}
+
+// Next advances the iterator to the next movie, which is then available through
+// the Movie method, it returns false once every movie has been yielded, the
+// MaxItems limit has been reached, an error has occurred or Close has been called.
+func (it *SearchMoviesIterator) Next() bool {
+	return it.pages.next()
+}
+
+// Movie returns the movie the iterator was advanced to by the last call to Next.
+func (it *SearchMoviesIterator) Movie() Movie {
+	return it.pages.current
+}
+
+// Err returns the error which stopped the iteration if any.
+func (it *SearchMoviesIterator) Err() error {
+	return it.pages.err
+}
+
+// Close stops the iteration and cancels the prefetch of the next page if any, it
+// is safe to call Close more than once.
+func (it *SearchMoviesIterator) Close() {
+	it.pages.close()
+}
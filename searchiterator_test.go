@@ -0,0 +1,133 @@
+package yts_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func TestClient_SearchMoviesAllWithContext(t *testing.T) {
+	const (
+		methodName  = "Client.SearchMoviesAll"
+		testdataDir = "search_movies_iterator"
+		pattern     = "api/v2/list_movies.json"
+	)
+
+	validFilters := yts.DefaultSearchMoviesFilters("")
+	validFilters.Limit = 2
+
+	invalidFilters := *validFilters
+	invalidFilters.Limit = 51
+
+	zeroPageFilters := *validFilters
+	zeroPageFilters.Page = 0
+
+	tests := []struct {
+		name         string
+		filters      *yts.SearchMoviesFilters
+		config       *yts.IteratorConfig
+		pages        []int
+		wantIDs      []int
+		wantRequests int32
+		wantErr      error
+		wantIterErr  error
+	}{
+		{
+			name:    "returns error when filters are nil",
+			filters: nil,
+			wantErr: yts.ErrFilterValidationFailure,
+		},
+		{
+			name:    "returns error when filters are invalid",
+			filters: &invalidFilters,
+			wantErr: yts.ErrFilterValidationFailure,
+		},
+		{
+			name:    "returns error when max items is negative",
+			filters: validFilters,
+			config:  &yts.IteratorConfig{MaxItems: -1},
+			wantErr: yts.ErrValidationFailure,
+		},
+		{
+			name:         "yields every movie once walking pages until exhausted",
+			filters:      validFilters,
+			config:       nil,
+			pages:        []int{1, 2, 3},
+			wantIDs:      []int{1, 2, 3, 4, 5},
+			wantRequests: 3,
+		},
+		{
+			name:         "starts from the first page when the page is zero",
+			filters:      &zeroPageFilters,
+			config:       nil,
+			pages:        []int{1, 2, 3},
+			wantIDs:      []int{1, 2, 3, 4, 5},
+			wantRequests: 3,
+		},
+		{
+			name:         "yields every movie once when prefetching the next page",
+			filters:      validFilters,
+			config:       &yts.IteratorConfig{Prefetch: true},
+			pages:        []int{1, 2, 3},
+			wantIDs:      []int{1, 2, 3, 4, 5},
+			wantRequests: 3,
+		},
+		{
+			name:         "stops yielding movies once max items is reached",
+			filters:      validFilters,
+			config:       &yts.IteratorConfig{MaxItems: 3},
+			pages:        []int{1, 2, 3},
+			wantIDs:      []int{1, 2, 3},
+			wantRequests: 2,
+		},
+		{
+			name:         "does not prefetch pages past max items",
+			filters:      validFilters,
+			config:       &yts.IteratorConfig{MaxItems: 2, Prefetch: true},
+			pages:        []int{1, 2, 3},
+			wantIDs:      []int{1, 2},
+			wantRequests: 1,
+		},
+		{
+			name:         "stops iterating with error when a page fails",
+			filters:      validFilters,
+			config:       nil,
+			pages:        []int{1, 2},
+			wantIDs:      []int{1, 2, 3},
+			wantRequests: 3,
+			wantIterErr:  yts.ErrUnexpectedHTTPResponseStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := &testRequestCounter{}
+			handlerCfgs := pagedHandlerConfigs(t, pattern, testdataDir, "page", "page_%d.json", counter, tt.pages...)
+			server := createTestServer(t, handlerCfgs...)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.APIBaseURL = *serverURL.JoinPath("api", "v2")
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+
+			it, err := c.SearchMoviesAllWithContext(context.Background(), tt.filters, tt.config)
+			assertError(t, methodName, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			defer it.Close()
+
+			var ids []int
+			for it.Next() {
+				ids = append(ids, it.Movie().ID)
+			}
+
+			assertError(t, methodName, it.Err(), tt.wantIterErr)
+			assertEqual(t, methodName, ids, tt.wantIDs)
+			assertEqual(t, methodName, counter.requests.Load(), tt.wantRequests)
+		})
+	}
+}
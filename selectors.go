@@ -0,0 +1,353 @@
+package yts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/andybalholm/cascadia"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// A Selectors instance holds the CSS selectors used by a `yts.Client` for scraping
+// the YTS website, overriding these allows you to keep up with changes made to the
+// markup of the YTS website without waiting for a new release of this package.
+// Empty fields fall back to the corresponding field of DefaultSelectors().
+type Selectors struct {
+	// The movie cards shown on the "/trending-movies" page.
+	Trending string `json:"trending"`
+
+	// The movie cards shown in the popular downloads section of the home page.
+	Popular string `json:"popular"`
+
+	// The movie cards shown in the latest torrents section of the home page.
+	Latest string `json:"latest"`
+
+	// The movie cards shown in the upcoming movies section of the home page.
+	Upcoming string `json:"upcoming"`
+
+	// The director section of a movie page.
+	Director string `json:"director"`
+
+	// The following selectors are matched within a single movie card.
+	MovieBottom   string `json:"movie_bottom"`
+	MovieLink     string `json:"movie_link"`
+	MovieImage    string `json:"movie_image"`
+	MovieRating   string `json:"movie_rating"`
+	MovieYear     string `json:"movie_year"`
+	MovieTitle    string `json:"movie_title"`
+	MovieProgress string `json:"movie_progress"`
+	MovieGenre    string `json:"movie_genre"`
+
+	// The following selectors are matched within the director section.
+	DirectorThumb string `json:"director_thumb"`
+	DirectorName  string `json:"director_name"`
+
+	// The reviews shown on a movie page, and the link to the remaining reviews.
+	Reviews     string `json:"reviews"`
+	ReviewsMore string `json:"reviews_more"`
+
+	// The following selectors are matched within a single review.
+	ReviewRating  string `json:"review_rating"`
+	ReviewAuthor  string `json:"review_author"`
+	ReviewTitle   string `json:"review_title"`
+	ReviewContent string `json:"review_content"`
+
+	// The element of a movie page carrying the "data-movie-id" attribute, and the
+	// comment count shown on a movie page.
+	MovieID      string `json:"movie_id"`
+	CommentCount string `json:"comment_count"`
+
+	// The comments returned by the comments endpoint, and the selectors matched
+	// within a single comment.
+	Comment          string `json:"comment"`
+	CommentAvatar    string `json:"comment_avatar"`
+	CommentLikeCount string `json:"comment_like_count"`
+	CommentAuthor    string `json:"comment_author"`
+	CommentTimestamp string `json:"comment_timestamp"`
+	CommentContent   string `json:"comment_content"`
+
+	// The reply count shown for a comment, when absent the number of replies nested
+	// within the comment, which are matched by the Comment selector, is used.
+	CommentReplyCount string `json:"comment_reply_count"`
+
+	// The main content of a movie page, and the following selectors matched within
+	// it for scraping the details of the movie.
+	Details              string `json:"details"`
+	DetailsTitle         string `json:"details_title"`
+	DetailsYear          string `json:"details_year"`
+	DetailsGenres        string `json:"details_genres"`
+	DetailsLikes         string `json:"details_likes"`
+	DetailsIMDbRating    string `json:"details_imdb_rating"`
+	DetailsIMDbLink      string `json:"details_imdb_link"`
+	DetailsCriticsScore  string `json:"details_critics_score"`
+	DetailsAudienceScore string `json:"details_audience_score"`
+	DetailsSynopsis      string `json:"details_synopsis"`
+	DetailsCast          string `json:"details_cast"`
+	DetailsTechSpecs     string `json:"details_tech_specs"`
+
+	// The following selectors are matched within a single cast member.
+	CastName  string `json:"cast_name"`
+	CastInfo  string `json:"cast_info"`
+	CastThumb string `json:"cast_thumb"`
+
+	// The following selectors are matched within the tech specs of a single quality,
+	// the label carrying the name of the spec in its "title" attribute.
+	TechSpecElement string `json:"tech_spec_element"`
+	TechSpecLabel   string `json:"tech_spec_label"`
+
+	// The links of the "Similar Movies" block of a movie page, and the image matched
+	// within each link.
+	SimilarMovies     string `json:"similar_movies"`
+	SimilarMovieImage string `json:"similar_movie_image"`
+
+	// The movie cards shown on a "/browse-movies" page, the total number of movies
+	// found, and the links and current page of its pagination.
+	BrowseMovies      string `json:"browse_movies"`
+	BrowseMovieCount  string `json:"browse_movie_count"`
+	BrowsePagination  string `json:"browse_pagination"`
+	BrowseCurrentPage string `json:"browse_current_page"`
+
+	// The total review count, the reviews and the element linking to the next page
+	// of the full review listing linked to by a movie page, currently hosted by IMDb,
+	// followed by the selectors matched within a single review of the listing.
+	ReviewsPageCount   string `json:"reviews_page_count"`
+	ReviewsPageReview  string `json:"reviews_page_review"`
+	ReviewsPageMore    string `json:"reviews_page_more"`
+	ReviewsPageAuthor  string `json:"reviews_page_author"`
+	ReviewsPageRating  string `json:"reviews_page_rating"`
+	ReviewsPageTitle   string `json:"reviews_page_title"`
+	ReviewsPageContent string `json:"reviews_page_content"`
+}
+
+// DefaultSelectors returns the CSS selectors matching the current markup of the
+// YTS website, which are used by the ClientConfig returned by DefaultClientConfig().
+func DefaultSelectors() Selectors {
+	return Selectors{
+		Trending:             "div.browse-movie-wrap",
+		Popular:              "div#popular-downloads div.browse-movie-wrap",
+		Latest:               "div.content-dark div.home-movies div.browse-movie-wrap",
+		Upcoming:             "div.content-dark ~ div.home-content div.browse-movie-wrap",
+		Director:             "div#movie-content div#movie-sub-info div#crew div.directors",
+		MovieBottom:          "div.browse-movie-bottom",
+		MovieLink:            "a.browse-movie-link",
+		MovieImage:           "img",
+		MovieRating:          "h4.rating",
+		MovieYear:            "div.browse-movie-year",
+		MovieTitle:           "a.browse-movie-title",
+		MovieProgress:        "div.browse-movie-year progress",
+		MovieGenre:           "div.browse-movie-wrap h4:not([class='rating'])",
+		DirectorThumb:        "div.list-cast a.avatar-thumb img",
+		DirectorName:         "div.list-cast-info a.name-cast span span",
+		Reviews:              "div#movie-reviews div.review",
+		ReviewsMore:          "div#movie-reviews a.more-reviews",
+		ReviewRating:         "div.review-properties span.review-rating",
+		ReviewAuthor:         "div.review-properties span.review-author",
+		ReviewTitle:          "h4",
+		ReviewContent:        "article",
+		MovieID:              "div#movie-info[data-movie-id]",
+		CommentCount:         "div#movie-comments span#comment-count",
+		Comment:              "div.comment",
+		CommentAvatar:        "div.comment a.avatar-thumb img",
+		CommentLikeCount:     "div.comment div.comment-likes span.comment-like-count",
+		CommentAuthor:        "div.comment div.comment-likes + span a",
+		CommentTimestamp:     "div.comment div.comment-likes + span",
+		CommentContent:       "div.comment div.comment-text p",
+		CommentReplyCount:    "div.comment div.comment-text span.comment-reply-count",
+		Details:              "div#movie-content",
+		DetailsTitle:         "div#movie-info div.hidden-xs h1",
+		DetailsYear:          "div#movie-info div.hidden-xs h2:nth-of-type(1)",
+		DetailsGenres:        "div#movie-info div.hidden-xs h2:nth-of-type(2)",
+		DetailsLikes:         "div#movie-info span#movie-likes",
+		DetailsIMDbRating:    "div#movie-info span[itemprop='ratingValue']",
+		DetailsIMDbLink:      "div#movie-info a[title='IMDb Rating']",
+		DetailsCriticsScore:  "div#movie-info a[title='Rotten Tomatoes Critics Score'] + span",
+		DetailsAudienceScore: "div#movie-info a[title='Rotten Tomatoes Audience Score'] + span",
+		DetailsSynopsis:      "div#synopsis p.hidden-xs",
+		DetailsCast:          "div#crew div.actors div.list-cast",
+		DetailsTechSpecs:     "div#movie-tech-specs div.tech-spec-info",
+		CastName:             "div.list-cast-info a.name-cast span[itemprop='name']",
+		CastInfo:             "div.list-cast-info",
+		CastThumb:            "a.avatar-thumb img",
+		TechSpecElement:      "div.tech-spec-element",
+		TechSpecLabel:        "span[title]",
+		SimilarMovies:        "div#movie-related a",
+		SimilarMovieImage:    "img",
+		BrowseMovies:         "div.browse-content section div.browse-movie-wrap",
+		BrowseMovieCount:     "div.browse-content h2 b",
+		BrowsePagination:     "ul.tsc_pagination li a",
+		BrowseCurrentPage:    "ul.tsc_pagination li a.current",
+		ReviewsPageCount:     "div.lister div.header div > span",
+		ReviewsPageReview:    "div.lister-list div.review-container",
+		ReviewsPageMore:      "div.load-more-data",
+		ReviewsPageAuthor:    "span.display-name-link a",
+		ReviewsPageRating:    "span.rating-other-user-rating",
+		ReviewsPageTitle:     "a.title",
+		ReviewsPageContent:   "div.content div.text",
+	}
+}
+
+// LoadSelectors decodes the JSON encoded Selectors read from the provided reader,
+// the fields missing from the input are set to their DefaultSelectors() value.
+// The Selectors returned can then be applied to a running `yts.Client` using its
+// SetSelectors method.
+func LoadSelectors(r io.Reader) (Selectors, error) {
+	selectors := DefaultSelectors()
+	if err := json.NewDecoder(r).Decode(&selectors); err != nil {
+		return Selectors{}, fmt.Errorf("failed to decode selectors, %w", err)
+	}
+
+	if err := selectors.validate(); err != nil {
+		return Selectors{}, wrapErr(ErrValidationFailure, err)
+	}
+
+	return selectors, nil
+}
+
+// fields returns pointers to every field of the Selectors keyed by their JSON name.
+func (s *Selectors) fields() map[string]*string {
+	return map[string]*string{
+		"trending":               &s.Trending,
+		"popular":                &s.Popular,
+		"latest":                 &s.Latest,
+		"upcoming":               &s.Upcoming,
+		"director":               &s.Director,
+		"movie_bottom":           &s.MovieBottom,
+		"movie_link":             &s.MovieLink,
+		"movie_image":            &s.MovieImage,
+		"movie_rating":           &s.MovieRating,
+		"movie_year":             &s.MovieYear,
+		"movie_title":            &s.MovieTitle,
+		"movie_progress":         &s.MovieProgress,
+		"movie_genre":            &s.MovieGenre,
+		"director_thumb":         &s.DirectorThumb,
+		"director_name":          &s.DirectorName,
+		"reviews":                &s.Reviews,
+		"reviews_more":           &s.ReviewsMore,
+		"review_rating":          &s.ReviewRating,
+		"review_author":          &s.ReviewAuthor,
+		"review_title":           &s.ReviewTitle,
+		"review_content":         &s.ReviewContent,
+		"movie_id":               &s.MovieID,
+		"comment_count":          &s.CommentCount,
+		"comment":                &s.Comment,
+		"comment_avatar":         &s.CommentAvatar,
+		"comment_like_count":     &s.CommentLikeCount,
+		"comment_author":         &s.CommentAuthor,
+		"comment_timestamp":      &s.CommentTimestamp,
+		"comment_content":        &s.CommentContent,
+		"comment_reply_count":    &s.CommentReplyCount,
+		"details":                &s.Details,
+		"details_title":          &s.DetailsTitle,
+		"details_year":           &s.DetailsYear,
+		"details_genres":         &s.DetailsGenres,
+		"details_likes":          &s.DetailsLikes,
+		"details_imdb_rating":    &s.DetailsIMDbRating,
+		"details_imdb_link":      &s.DetailsIMDbLink,
+		"details_critics_score":  &s.DetailsCriticsScore,
+		"details_audience_score": &s.DetailsAudienceScore,
+		"details_synopsis":       &s.DetailsSynopsis,
+		"details_cast":           &s.DetailsCast,
+		"details_tech_specs":     &s.DetailsTechSpecs,
+		"cast_name":              &s.CastName,
+		"cast_info":              &s.CastInfo,
+		"cast_thumb":             &s.CastThumb,
+		"tech_spec_element":      &s.TechSpecElement,
+		"tech_spec_label":        &s.TechSpecLabel,
+		"similar_movies":         &s.SimilarMovies,
+		"similar_movie_image":    &s.SimilarMovieImage,
+		"browse_movies":          &s.BrowseMovies,
+		"browse_movie_count":     &s.BrowseMovieCount,
+		"browse_pagination":      &s.BrowsePagination,
+		"browse_current_page":    &s.BrowseCurrentPage,
+		"reviews_page_count":     &s.ReviewsPageCount,
+		"reviews_page_review":    &s.ReviewsPageReview,
+		"reviews_page_more":      &s.ReviewsPageMore,
+		"reviews_page_author":    &s.ReviewsPageAuthor,
+		"reviews_page_rating":    &s.ReviewsPageRating,
+		"reviews_page_title":     &s.ReviewsPageTitle,
+		"reviews_page_content":   &s.ReviewsPageContent,
+	}
+}
+
+// withDefaults returns a copy of the Selectors with its empty fields set to their
+// DefaultSelectors() value.
+func (s Selectors) withDefaults() Selectors {
+	var (
+		defaults       = DefaultSelectors()
+		defaultsFields = defaults.fields()
+	)
+
+	for name, field := range s.fields() {
+		if *field == "" {
+			*field = *defaultsFields[name]
+		}
+	}
+
+	return s
+}
+
+func (s *Selectors) validate() error {
+	errs := validation.Errors{}
+	for name, field := range s.fields() {
+		if *field == "" {
+			continue
+		}
+
+		if _, err := cascadia.ParseGroup(*field); err != nil {
+			errs[name] = fmt.Errorf("invalid selector %q, %w", *field, err)
+		}
+	}
+
+	return errs.Filter()
+}
+
+// selectorStore holds the Selectors in use by a `yts.Client`, allowing these to be
+// replaced while requests are being made.
+type selectorStore struct {
+	mu        sync.RWMutex
+	selectors Selectors
+}
+
+func newSelectorStore(selectors Selectors) *selectorStore {
+	return &selectorStore{selectors: selectors}
+}
+
+// load returns a copy of the Selectors currently in use, so that a scrape is not
+// affected by the Selectors being replaced midway.
+func (ss *selectorStore) load() *Selectors {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	selectors := ss.selectors
+	return &selectors
+}
+
+func (ss *selectorStore) store(selectors Selectors) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.selectors = selectors
+}
+
+// Selectors returns the Selectors currently used by the client for scraping the
+// YTS website, with empty fields set to their DefaultSelectors() value.
+func (c *Client) Selectors() Selectors {
+	return *c.selectors.load()
+}
+
+// SetSelectors replaces the Selectors used by the client for scraping the YTS
+// website, allowing selectors to be patched without creating a new client, empty
+// fields fall back to their DefaultSelectors() value. The provided selectors are
+// validated and ErrValidationFailure is returned if any of them is invalid, in
+// which case the Selectors in use are left unchanged. Every section of a page is
+// scraped using the Selectors in use when its scraping starts, a call spanning
+// several sections or pages, such as the MoviePage accessors and the iterators,
+// may hence scrape some of them using the previous Selectors.
+func (c *Client) SetSelectors(selectors Selectors) error {
+	if err := selectors.validate(); err != nil {
+		return wrapErr(ErrValidationFailure, err)
+	}
+
+	c.selectors.store(selectors.withDefaults())
+	return nil
+}
@@ -0,0 +1,184 @@
+package yts_test
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	yts "github.com/atifcppprogrammer/yflicks-yts"
+)
+
+func TestLoadSelectors(t *testing.T) {
+	const methodName = "LoadSelectors"
+
+	overridden := yts.DefaultSelectors()
+	overridden.Trending = "div.movie-card"
+
+	tests := []struct {
+		name    string
+		input   string
+		want    yts.Selectors
+		wantErr error
+	}{
+		{
+			name:    "returns default selectors for empty object",
+			input:   `{}`,
+			want:    yts.DefaultSelectors(),
+			wantErr: nil,
+		},
+		{
+			name:    "overrides only the provided selectors",
+			input:   `{"trending": "div.movie-card"}`,
+			want:    overridden,
+			wantErr: nil,
+		},
+		{
+			name:    "returns error for syntactically invalid selector",
+			input:   `{"popular": "div#popular-downloads >"}`,
+			want:    yts.Selectors{},
+			wantErr: yts.ErrValidationFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := yts.LoadSelectors(strings.NewReader(tt.input))
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, got, tt.want)
+		})
+	}
+}
+
+func TestNewClientWithConfig_Selectors(t *testing.T) {
+	const methodName = "NewClientWithConfig"
+
+	tests := []struct {
+		name      string
+		selectors yts.Selectors
+		wantErr   error
+	}{
+		{
+			name:      "returns error for syntactically invalid selector",
+			selectors: yts.Selectors{Comment: "div.comment["},
+			wantErr:   yts.ErrInvalidClientConfig,
+		},
+		{
+			name:      "returns nil error for empty selectors",
+			selectors: yts.Selectors{},
+			wantErr:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.Selectors = tt.selectors
+			_, err := yts.NewClientWithConfig(&clientCfg)
+			assertError(t, methodName, err, tt.wantErr)
+		})
+	}
+}
+
+func TestClient_Selectors(t *testing.T) {
+	const (
+		methodName  = "Client.TrendingMovies"
+		testdataDir = "trending_movies"
+		pattern     = "/"
+	)
+
+	handlerCfg := defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html")
+	server := createTestServer(t, handlerCfg)
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		selectors yts.Selectors
+		wantErr   error
+	}{
+		{
+			name:      "uses default selectors for empty fields",
+			selectors: yts.Selectors{MovieTitle: "a.browse-movie-title"},
+			wantErr:   nil,
+		},
+		{
+			name:      "uses overridden selectors",
+			selectors: yts.Selectors{Trending: "div.movie-card"},
+			wantErr:   yts.ErrContentRetrievalFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.SiteURL = *serverURL
+			clientCfg.Selectors = tt.selectors
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			_, err := c.TrendingMoviesWithContext(context.Background())
+			assertError(t, methodName, err, tt.wantErr)
+		})
+	}
+}
+
+func TestClient_SetSelectors(t *testing.T) {
+	const (
+		methodName  = "Client.SetSelectors"
+		testdataDir = "trending_movies"
+		pattern     = "/"
+	)
+
+	handlerCfg := defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html")
+	server := createTestServer(t, handlerCfg)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.SiteURL = *serverURL
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+
+	patched, err := yts.LoadSelectors(strings.NewReader(`{"trending": "div.movie-card"}`))
+	assertError(t, "LoadSelectors", err, nil)
+
+	tests := []struct {
+		name          string
+		selectors     yts.Selectors
+		wantErr       error
+		wantSelectors yts.Selectors
+		wantScrapeErr error
+	}{
+		{
+			name:          "returns error and keeps selectors for invalid selector",
+			selectors:     yts.Selectors{Trending: "div.movie-card["},
+			wantErr:       yts.ErrValidationFailure,
+			wantSelectors: yts.DefaultSelectors(),
+			wantScrapeErr: nil,
+		},
+		{
+			name:          "swaps selectors loaded at runtime on live client",
+			selectors:     patched,
+			wantErr:       nil,
+			wantSelectors: patched,
+			wantScrapeErr: yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:          "restores default selectors for empty fields",
+			selectors:     yts.Selectors{},
+			wantErr:       nil,
+			wantSelectors: yts.DefaultSelectors(),
+			wantScrapeErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.SetSelectors(tt.selectors)
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, c.Selectors(), tt.wantSelectors)
+
+			_, err = c.TrendingMoviesWithContext(context.Background())
+			assertError(t, methodName, err, tt.wantScrapeErr)
+		})
+	}
+}
@@ -2,12 +2,17 @@ package yts
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strings"
 	"time"
+
+	"github.com/PuerkitoBio/goquery"
 )
 
 const (
@@ -30,8 +35,6 @@ const (
 	TimeoutLimitLower = 5 * time.Second
 )
 
-var debug = newLogger()
-
 // A ClientConfig allows you to configure the behavior of the `yts.Client` instance
 // created by NewClient() function.
 type ClientConfig struct {
@@ -43,6 +46,23 @@ type ClientConfig struct {
 	// never have to specify a value for this other than DefaultSiteURL
 	SiteURL url.URL
 
+	// An ordered list of alternative base URLs for the YTS API, these are tried in
+	// turn when requests made to APIBaseURL fail due to DNS, connection or server
+	// errors, the client then sticks to the last healthy mirror.
+	APIMirrors []url.URL
+
+	// An ordered list of alternative base URLs for the YTS website, these are used
+	// in the same manner as APIMirrors but for requests made to SiteURL.
+	SiteMirrors []url.URL
+
+	// The duration for which a failed mirror is considered unhealthy, unhealthy
+	// mirrors are only tried once every healthy mirror has failed.
+	MirrorCooldown time.Duration
+
+	// The name used in the display names of the magnet links prepared by the
+	// `MagnetLinks()` method, the upper cased host of SiteURL is used when empty.
+	TorrentBrand string
+
 	// The list of torrent tracker URLs used by the `MagnetLinks()` method for
 	// preparing magnet links for movie torrents.
 	TorrentTrackers []string
@@ -52,9 +72,63 @@ type ClientConfig struct {
 	// *yts.Client.
 	RequestTimeout time.Duration
 
-	// This flag "switches on" an internal logger and is intended for use by developers
-	// for debugging purposes, if you encounter a bug in this package turning this flag
-	// on will reveal greater detail regarding the error in question.
+	// An optional *http.Client used for making network requests, this allows you to
+	// configure proxies, TLS settings and connection pooling. The client is copied
+	// and its Timeout is set to RequestTimeout, this field cannot be provided in
+	// conjunction with the Transport field.
+	HTTPClient *http.Client
+
+	// An optional http.RoundTripper used by the internal *http.Client for making
+	// network requests, http.DefaultTransport is used when this is nil.
+	Transport http.RoundTripper
+
+	// An optional chain of RoundTripperMiddleware used for wrapping the transport of
+	// the internal *http.Client, the first middleware is the outermost wrapper and
+	// hence sees each request first.
+	Middleware []RoundTripperMiddleware
+
+	// The policy used for retrying failed requests made to both the YTS API and the
+	// YTS website, a zero value RetryPolicy disables retries.
+	RetryPolicy RetryPolicy
+
+	// The rate limit applied to requests made to the YTS API, a zero value RateLimit
+	// disables rate limiting for these requests.
+	APIRateLimit RateLimit
+
+	// The rate limit applied to requests made to the YTS website, a zero value
+	// RateLimit disables rate limiting for these requests.
+	SiteRateLimit RateLimit
+
+	// The rate limit applied to requests made to hosts outside the YTS API and the
+	// YTS website, such as the IMDb review pages linked from movie pages, a zero
+	// value RateLimit disables rate limiting for these requests.
+	OffSiteRateLimit RateLimit
+
+	// The configuration for caching responses of the YTS API and the YTS website,
+	// caching is disabled for a zero value CacheConfig.
+	Cache CacheConfig
+
+	// This flag enables HTTP conditional requests, the client remembers the bodies
+	// of responses carrying "ETag" or "Last-Modified" headers and revalidates them
+	// with "If-None-Match" and "If-Modified-Since" headers on subsequent requests.
+	ConditionalRequests bool
+
+	// The CSS selectors used by the client methods scraping the YTS website, empty
+	// fields fall back to the corresponding field of DefaultSelectors().
+	Selectors Selectors
+
+	// The ScrapeMode used by the client methods scraping the YTS website, which can
+	// be overridden for a single method call using WithScrapeMode.
+	ScrapeMode ScrapeMode
+
+	// The structured logger used by the client for reporting requests, retries,
+	// mirror failovers and scraping failures, nothing is logged when this is nil
+	// unless the Debug flag is set.
+	Logger *slog.Logger
+
+	// This flag is a convenience for developers debugging this package, when set
+	// and no Logger has been provided, the client logs records of every level to
+	// stdout using a text handler.
 	Debug bool
 }
 
@@ -62,8 +136,17 @@ type ClientConfig struct {
 // this instance's method to interact with the YTS API and fetch content scraped
 // from the YTS website.
 type Client struct {
-	config    ClientConfig
-	netClient *http.Client
+	config         ClientConfig
+	netClient      *http.Client
+	apiLimiter     *tokenBucket
+	siteLimiter    *tokenBucket
+	offSiteLimiter *tokenBucket
+	apiMirrors     *mirrorPool
+	siteMirrors    *mirrorPool
+	cache          *responseCache
+	movieIDs       *MemoryCache
+	selectors      *selectorStore
+	logger         *slog.Logger
 }
 
 var (
@@ -74,7 +157,8 @@ var (
 
 	// ErrContentRetrievalFailure indicates that a yts.Client method failed to scrape
 	// content from the YTS Site, and may indicate the presence of a bug, please
-	// report these by creating an issue at the following URL.
+	// report these by creating an issue at the following URL, including the details
+	// carried by the wrapping *ScrapeError.
 	// https://github.com/atifcppprogrammer/yflicks-yts/issues/new.
 	ErrContentRetrievalFailure = errors.New("content_retrieval_failure")
 
@@ -83,6 +167,10 @@ var (
 	// details.
 	ErrFilterValidationFailure = errors.New("filter_validation_failure")
 
+	// ErrMovieNotFound indicates that the YTS API returned an empty movie for the
+	// movie ID provided to the MovieDetails method, i.e. no such movie exists.
+	ErrMovieNotFound = errors.New("movie_not_found")
+
 	// A ErrValidationFailure is reported whenever you provided an invalid value for
 	// an input argument to one of the methods of the yts.Client method, the error
 	// description will carry further information.
@@ -119,11 +207,16 @@ func DefaultClientConfig() ClientConfig {
 	)
 
 	return ClientConfig{
-		APIBaseURL:      *parsedAPIBaseURL,
-		SiteURL:         *parsedSiteURL,
-		RequestTimeout:  time.Minute,
-		TorrentTrackers: DefaultTorrentTrackers(),
-		Debug:           false,
+		APIBaseURL:          *parsedAPIBaseURL,
+		SiteURL:             *parsedSiteURL,
+		RequestTimeout:      time.Minute,
+		MirrorCooldown:      DefaultMirrorCooldown,
+		RetryPolicy:         DefaultRetryPolicy(),
+		OffSiteRateLimit:    DefaultOffSiteRateLimit(),
+		ConditionalRequests: true,
+		TorrentTrackers:     DefaultTorrentTrackers(),
+		Selectors:           DefaultSelectors(),
+		Debug:               false,
 	}
 }
 
@@ -148,12 +241,79 @@ func NewClientWithConfig(config *ClientConfig) (*Client, error) {
 		return nil, wrapErr(ErrInvalidClientConfig, err)
 	}
 
-	if config.Debug {
-		debug.setDebug(true)
+	if err := validateMirrors(config.APIMirrors); err != nil {
+		err = fmt.Errorf("invalid API mirrors, %w", err)
+		return nil, wrapErr(ErrInvalidClientConfig, err)
+	}
+
+	if err := validateMirrors(config.SiteMirrors); err != nil {
+		err = fmt.Errorf("invalid site mirrors, %w", err)
+		return nil, wrapErr(ErrInvalidClientConfig, err)
+	}
+
+	if config.MirrorCooldown < 0 {
+		err := fmt.Errorf("mirror cooldown must be >= 0, you provided %q", config.MirrorCooldown)
+		return nil, wrapErr(ErrInvalidClientConfig, err)
+	}
+
+	if config.HTTPClient != nil && config.Transport != nil {
+		err := fmt.Errorf("only one of http client and transport can be provided")
+		return nil, wrapErr(ErrInvalidClientConfig, err)
+	}
+
+	if err := config.RetryPolicy.validate(); err != nil {
+		err = fmt.Errorf("invalid retry policy, %w", err)
+		return nil, wrapErr(ErrInvalidClientConfig, err)
+	}
+
+	if err := config.APIRateLimit.validate(); err != nil {
+		err = fmt.Errorf("invalid API rate limit, %w", err)
+		return nil, wrapErr(ErrInvalidClientConfig, err)
+	}
+
+	if err := config.SiteRateLimit.validate(); err != nil {
+		err = fmt.Errorf("invalid site rate limit, %w", err)
+		return nil, wrapErr(ErrInvalidClientConfig, err)
+	}
+
+	if err := config.OffSiteRateLimit.validate(); err != nil {
+		err = fmt.Errorf("invalid off-site rate limit, %w", err)
+		return nil, wrapErr(ErrInvalidClientConfig, err)
+	}
+
+	if err := config.Cache.validate(); err != nil {
+		err = fmt.Errorf("invalid cache config, %w", err)
+		return nil, wrapErr(ErrInvalidClientConfig, err)
 	}
 
-	netClient := &http.Client{Timeout: config.RequestTimeout}
-	return &Client{*config, netClient}, nil
+	if err := config.Selectors.validate(); err != nil {
+		err = fmt.Errorf("invalid selectors, %w", err)
+		return nil, wrapErr(ErrInvalidClientConfig, err)
+	}
+
+	if err := config.ScrapeMode.validate(); err != nil {
+		err = fmt.Errorf("invalid scrape mode, %w", err)
+		return nil, wrapErr(ErrInvalidClientConfig, err)
+	}
+
+	clientConfig := *config
+	clientConfig.Selectors = config.Selectors.withDefaults()
+
+	client := &Client{
+		config:         clientConfig,
+		netClient:      newNetClient(config),
+		apiLimiter:     newTokenBucket(config.APIRateLimit),
+		siteLimiter:    newTokenBucket(config.SiteRateLimit),
+		offSiteLimiter: newTokenBucket(config.OffSiteRateLimit),
+		apiMirrors:     newMirrorPool(config.APIBaseURL, config.APIMirrors, config.MirrorCooldown),
+		siteMirrors:    newMirrorPool(config.SiteURL, config.SiteMirrors, config.MirrorCooldown),
+		cache:          newResponseCache(config),
+		movieIDs:       NewMemoryCache(movieIDCacheCapacity),
+		selectors:      newSelectorStore(clientConfig.Selectors),
+		logger:         newLogger(config),
+	}
+
+	return client, nil
 }
 
 // NewClient returns a new `*yts.Client` instance with the internal ClientConfig
@@ -185,6 +345,45 @@ type BaseResponse struct {
 	Meta          `json:"@meta"`
 }
 
+// StatusOK is the value of the Status field of a BaseResponse for successful
+// responses of the YTS API.
+const StatusOK = "ok"
+
+// An APIError is returned by the `yts.Client` methods which use the YTS API when
+// the API responds with a status other than StatusOK, for instance "error" in
+// the event of invalid query parameters.
+type APIError struct {
+	Status        string `json:"status"`
+	StatusMessage string `json:"status_message"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("api responded with status %q: %s", e.Status, e.StatusMessage)
+}
+
+// Is reports whether the target is an *APIError with the same Status and
+// StatusMessage, empty fields of the target match any value.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+
+	return (t.Status == "" || t.Status == e.Status) &&
+		(t.StatusMessage == "" || t.StatusMessage == e.StatusMessage)
+}
+
+func (br *BaseResponse) apiError() error {
+	if br.Status == StatusOK {
+		return nil
+	}
+
+	return &APIError{
+		Status:        br.Status,
+		StatusMessage: br.StatusMessage,
+	}
+}
+
 // A SearchMoviesResponse models the response of the "/api/v2/list_movies.json"
 // endpoint of YTS API (https://yts.mx/api#list_movies).
 type SearchMoviesResponse struct {
@@ -203,15 +402,11 @@ func (c *Client) SearchMoviesWithContext(ctx context.Context, filters *SearchMov
 		return nil, wrapErr(ErrFilterValidationFailure, err)
 	}
 
-	parsedPayload := &SearchMoviesResponse{}
 	targetURLString := c.getAPIEndpoint("list_movies.json", queryString)
 	targetURL, _ := url.Parse(targetURLString)
-	err = c.newJSONRequestWithContext(ctx, targetURL, parsedPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	return parsedPayload, nil
+	return newJSONRequestWithContext(
+		ctx, c, EndpointSearchMovies, targetURL, (*SearchMoviesResponse).apiError,
+	)
 }
 
 // SearchMovies returns the response of the "/api/v2/list_movies.json" endpoint with
@@ -243,29 +438,98 @@ func (c *Client) MovieDetailsWithContext(ctx context.Context, movieID int, filte
 		return nil, wrapErr(ErrValidationFailure, err)
 	}
 
-	queryString := fmt.Sprintf("movie_id=%d", movieID)
+	var (
+		identifier = fmt.Sprintf("movie_id=%d", movieID)
+		subject    = fmt.Sprintf("movieID %d", movieID)
+	)
+	return c.movieDetailsWithContext(ctx, identifier, subject, filters)
+}
+
+// movieDetailsWithContext requests the "/api/v2/movie_details.json" endpoint for
+// the movie identified by the provided query parameter e.g. "movie_id=57427", the
+// provided subject describing the movie in the error returned when none is found.
+func (c *Client) movieDetailsWithContext(
+	ctx context.Context, identifier, subject string, filters *MovieDetailsFilters,
+) (*MovieDetailsResponse, error) {
+	queryString := identifier
 	if q := filters.getQueryString(); q != "" {
-		queryString = fmt.Sprintf("movie_id=%d&%s", movieID, q)
+		queryString = fmt.Sprintf("%s&%s", identifier, q)
 	}
 
-	parsedPayload := &MovieDetailsResponse{}
-	targetURLString := c.getAPIEndpoint("movie_details.json", queryString)
-	targetURL, _ := url.Parse(targetURLString)
-	err := c.newJSONRequestWithContext(ctx, targetURL, parsedPayload)
-	if err != nil {
-		return nil, err
+	check := func(parsedPayload *MovieDetailsResponse) error {
+		if err := parsedPayload.apiError(); err != nil {
+			return err
+		}
+
+		if parsedPayload.Data.Movie.ID == 0 {
+			err := fmt.Errorf("no movie found for %s", subject)
+			return wrapErr(ErrMovieNotFound, err)
+		}
+
+		return nil
 	}
 
-	return parsedPayload, nil
+	targetURLString := c.getAPIEndpoint("movie_details.json", queryString)
+	targetURL, _ := url.Parse(targetURLString)
+	return newJSONRequestWithContext(ctx, c, EndpointMovieDetails, targetURL, check)
 }
 
 // MovieDetails returns the response of "/api/v2/movie_details.json" endpoint
 // with the provided filters and movieID, the provided movieID must be positive
-// integer a 404 error will be returned if no movie is found for provided movieID.
+// integer, ErrMovieNotFound will be returned if no movie is found for provided
+// movieID.
 func (c *Client) MovieDetails(movieID int, filters *MovieDetailsFilters) (*MovieDetailsResponse, error) {
 	return c.MovieDetailsWithContext(context.Background(), movieID, filters)
 }
 
+// imdbCodeRegex matches IMDb title codes such as "tt15398776".
+var imdbCodeRegex = regexp.MustCompile(`^tt\d{7,8}$`)
+
+// MovieDetailsByIMDbWithContext is the same as the MovieDetailsByIMDb method but
+// requires a context.Context argument to be passed, this context is then passed to
+// the http.NewRequestWithContext call used for making the network request.
+func (c *Client) MovieDetailsByIMDbWithContext(ctx context.Context, imdbCode string, filters *MovieDetailsFilters) (
+	*MovieDetailsResponse, error,
+) {
+	if !imdbCodeRegex.MatchString(imdbCode) {
+		err := fmt.Errorf("provided imdbCode %q must be of the form tt1234567", imdbCode)
+		return nil, wrapErr(ErrValidationFailure, err)
+	}
+
+	var (
+		identifier = fmt.Sprintf("imdb_id=%s", imdbCode)
+		subject    = fmt.Sprintf("imdbCode %s", imdbCode)
+	)
+	return c.movieDetailsWithContext(ctx, identifier, subject, filters)
+}
+
+// MovieDetailsByIMDb returns the response of "/api/v2/movie_details.json" endpoint
+// with the provided filters and IMDb code, the provided imdbCode must consist of
+// "tt" followed by 7 or 8 digits, ErrMovieNotFound will be returned if no movie is
+// found for provided imdbCode.
+func (c *Client) MovieDetailsByIMDb(imdbCode string, filters *MovieDetailsFilters) (*MovieDetailsResponse, error) {
+	return c.MovieDetailsByIMDbWithContext(context.Background(), imdbCode, filters)
+}
+
+// ResolveIMDbToIDWithContext is the same as the ResolveIMDbToID method but requires
+// a context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext call used for making the network request.
+func (c *Client) ResolveIMDbToIDWithContext(ctx context.Context, imdbCode string) (int, error) {
+	response, err := c.MovieDetailsByIMDbWithContext(ctx, imdbCode, &MovieDetailsFilters{})
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Data.Movie.ID, nil
+}
+
+// ResolveIMDbToID returns the ID in the YTS movie database of the movie with the
+// provided IMDb code, which can then be passed to the methods expecting a movieID,
+// ErrMovieNotFound will be returned if no movie is found for provided imdbCode.
+func (c *Client) ResolveIMDbToID(imdbCode string) (int, error) {
+	return c.ResolveIMDbToIDWithContext(context.Background(), imdbCode)
+}
+
 type MovieSuggestionsData struct {
 	MovieCount int     `json:"movie_count"`
 	Movies     []Movie `json:"movies"`
@@ -295,15 +559,11 @@ func (c *Client) MovieSuggestionsWithContext(ctx context.Context, movieID int) (
 		queryString = queryValues.Encode()
 	)
 
-	parsedPayload := &MovieSuggestionsResponse{}
 	targetURLString := c.getAPIEndpoint("movie_suggestions.json", queryString)
 	targetURL, _ := url.Parse(targetURLString)
-	err := c.newJSONRequestWithContext(ctx, targetURL, parsedPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	return parsedPayload, nil
+	return newJSONRequestWithContext(
+		ctx, c, EndpointMovieSuggestions, targetURL, (*MovieSuggestionsResponse).apiError,
+	)
 }
 
 // MovieSuggestions returns the response of the "/api/v2/movie_suggestions.json"
@@ -318,24 +578,12 @@ func (c *Client) MovieSuggestions(movieID int) (*MovieSuggestionsResponse, error
 // passed to the http.NewRequestWithContext call used for making the network
 // request.
 func (c *Client) ResolveMovieSlugToIDWithContext(ctx context.Context, movieSlug string) (int, error) {
-	if movieSlug == "" {
-		err := fmt.Errorf("provided movie slug cannot be an empty")
-		return 0, wrapErr(ErrValidationFailure, err)
-	}
-
-	pageURLString := fmt.Sprintf("%s/movies/%s", &c.config.SiteURL, movieSlug)
-	pageURL, _ := url.Parse(pageURLString)
-	document, err := c.newDocumentRequestWithContext(ctx, pageURL)
+	page, err := c.MoviePageWithContext(ctx, movieSlug)
 	if err != nil {
 		return 0, err
 	}
 
-	movieID, err := c.scrapeMovieID(document)
-	if err != nil {
-		return 0, ErrContentRetrievalFailure
-	}
-
-	return movieID, nil
+	return page.ID()
 }
 
 // ResolveMovieSlugToID method converts the provided movie slug to its corresponding
@@ -353,6 +601,8 @@ type TrendingMoviesData struct {
 // trending in the past 24 Hours.
 type TrendingMoviesResponse struct {
 	Data TrendingMoviesData `json:"data"`
+	// The trending movies omitted from Data as they failed to scrape.
+	ScrapeErrors []*ScrapeError `json:"scrape_errors,omitempty"`
 }
 
 // TrendingMoviesWithContext is the same as the TrendingMovies method but
@@ -363,17 +613,17 @@ func (c *Client) TrendingMoviesWithContext(ctx context.Context) (
 ) {
 	pageURLString := fmt.Sprintf("%s/trending-movies", &c.config.SiteURL)
 	pageURL, _ := url.Parse(pageURLString)
-	document, err := c.newDocumentRequestWithContext(ctx, pageURL)
-	if err != nil {
-		return nil, err
+	mode := c.scrapeModeFrom(ctx)
+	scrape := func(d *goquery.Document, _ time.Time) (*TrendingMoviesResponse, error) {
+		data, scrapeErrs, err := c.scrapeTrendingMoviesData(d, mode)
+		if err != nil {
+			return nil, err
+		}
+
+		return &TrendingMoviesResponse{*data, scrapeErrs}, nil
 	}
 
-	data, err := c.scrapeTrendingMoviesData(document)
-	if err != nil {
-		return nil, ErrContentRetrievalFailure
-	}
-
-	return &TrendingMoviesResponse{*data}, nil
+	return newDocumentRequestWithContext(ctx, c, EndpointTrendingMovies, pageURL, scrape)
 }
 
 // TrendingMovies method scrapes the "/trending" page of the YTS website and
@@ -393,6 +643,9 @@ type HomePageContentData struct {
 // trending and upcoming movie torrents.
 type HomePageContentResponse struct {
 	Data HomePageContentData `json:"data"`
+	// The popular, latest and upcoming movies omitted from Data as they failed to
+	// scrape.
+	ScrapeErrors []*ScrapeError `json:"scrape_errors,omitempty"`
 }
 
 // HomePageContentWithContext is the same as the HomePageContent method but
@@ -401,17 +654,17 @@ type HomePageContentResponse struct {
 func (c *Client) HomePageContentWithContext(ctx context.Context) (
 	*HomePageContentResponse, error,
 ) {
-	document, err := c.newDocumentRequestWithContext(ctx, &c.config.SiteURL)
-	if err != nil {
-		return nil, err
+	mode := c.scrapeModeFrom(ctx)
+	scrape := func(d *goquery.Document, _ time.Time) (*HomePageContentResponse, error) {
+		data, scrapeErrs, err := c.scrapeHomePageContentData(d, mode)
+		if err != nil {
+			return nil, err
+		}
+
+		return &HomePageContentResponse{*data, scrapeErrs}, nil
 	}
 
-	data, err := c.scrapeHomePageContentData(document)
-	if err != nil {
-		return nil, ErrContentRetrievalFailure
-	}
-
-	return &HomePageContentResponse{*data}, nil
+	return newDocumentRequestWithContext(ctx, c, EndpointHomePageContent, &c.config.SiteURL, scrape)
 }
 
 // HomePageContent method scrapes the popular, latest torrents and upcoming
@@ -421,6 +674,143 @@ func (c *Client) HomePageContent() (*HomePageContentResponse, error) {
 	return c.HomePageContentWithContext(context.Background())
 }
 
+type BrowseMoviesData struct {
+	MovieCount int         `json:"movie_count"`
+	PageNumber int         `json:"page_number"`
+	PageCount  int         `json:"page_count"`
+	Movies     []SiteMovie `json:"movies"`
+}
+
+// A BrowseMoviesResponse holds the content retrieved by scraping a "/browse-movies"
+// page of the YTS website, the content in question being the movies matching the
+// provided filters, the total number of movies found and the pagination state.
+type BrowseMoviesResponse struct {
+	Data BrowseMoviesData `json:"data"`
+	// The movies of the page omitted from Data as they failed to scrape.
+	ScrapeErrors []*ScrapeError `json:"scrape_errors,omitempty"`
+}
+
+// BrowseMoviesWithContext is the same as the BrowseMovies method but requires a
+// context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext call used for making the network request.
+func (c *Client) BrowseMoviesWithContext(ctx context.Context, filters *BrowseMoviesFilters) (
+	*BrowseMoviesResponse, error,
+) {
+	path, err := filters.getPath()
+	if err != nil {
+		return nil, wrapErr(ErrFilterValidationFailure, err)
+	}
+
+	pageURLString := fmt.Sprintf("%s%s", &c.config.SiteURL, path)
+	pageURL, _ := url.Parse(pageURLString)
+	mode := c.scrapeModeFrom(ctx)
+	scrape := func(d *goquery.Document, _ time.Time) (*BrowseMoviesResponse, error) {
+		data, scrapeErrs, err := c.scrapeBrowseMoviesData(d, mode)
+		if err != nil {
+			return nil, err
+		}
+
+		return &BrowseMoviesResponse{*data, scrapeErrs}, nil
+	}
+
+	return newDocumentRequestWithContext(ctx, c, EndpointBrowseMovies, pageURL, scrape)
+}
+
+// BrowseMovies method scrapes the "/browse-movies" page of the YTS website for the
+// provided filters, which are validated internally, an error being returned in the
+// event validation fails. Unlike SearchMovies it supports year ranges and language
+// filters, see the BrowseMoviesFilters type.
+func (c *Client) BrowseMovies(filters *BrowseMoviesFilters) (*BrowseMoviesResponse, error) {
+	return c.BrowseMoviesWithContext(context.Background(), filters)
+}
+
+// A QuickSearchResult represents a single suggestion returned by the ajax search
+// endpoint used by the search box in the header of the YTS website.
+type QuickSearchResult struct {
+	Title string `json:"title"`
+	Year  int    `json:"year"`
+	Slug  string `json:"slug"`
+	Link  string `json:"link"`
+	Image string `json:"image"`
+}
+
+type QuickSearchData struct {
+	Results []QuickSearchResult `json:"results"`
+}
+
+// A QuickSearchResponse contains the suggestions returned by the following
+// endpoint of the YTS website "/ajax/search?query={term}".
+type QuickSearchResponse struct {
+	Data QuickSearchData `json:"data"`
+}
+
+type quickSearchPayload struct {
+	BaseResponse
+	Data []struct {
+		URL   string      `json:"url"`
+		Img   string      `json:"img"`
+		Title string      `json:"title"`
+		Year  json.Number `json:"year"`
+	} `json:"data"`
+}
+
+// QuickSearchWithContext is the same as the QuickSearch method but requires a
+// context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext call used for making the network request. When
+// searching as the user types, cancel the context of the previous call on each
+// keystroke, the superseded call then returns the context error without waiting
+// for its response, and without making a request if it was cancelled beforehand.
+func (c *Client) QuickSearchWithContext(ctx context.Context, term string) (
+	*QuickSearchResponse, error,
+) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		err := fmt.Errorf("provided search term cannot be empty")
+		return nil, wrapErr(ErrValidationFailure, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var (
+		queryValues = url.Values{"query": []string{term}}
+		queryString = queryValues.Encode()
+	)
+
+	targetURLString := fmt.Sprintf("%s/ajax/search?%s", &c.config.SiteURL, queryString)
+	targetURL, _ := url.Parse(targetURLString)
+	parsedPayload, err := newJSONRequestWithContext(
+		ctx, c, EndpointQuickSearch, targetURL, (*quickSearchPayload).apiError,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]QuickSearchResult, 0, len(parsedPayload.Data))
+	for _, item := range parsedPayload.Data {
+		year, _ := item.Year.Int64()
+		results = append(results, QuickSearchResult{
+			Title: item.Title,
+			Year:  int(year),
+			Slug:  movieSlugFromLink(item.URL),
+			Link:  item.URL,
+			Image: item.Img,
+		})
+	}
+
+	return &QuickSearchResponse{QuickSearchData{results}}, nil
+}
+
+// QuickSearch returns the lightweight suggestions shown by the search box in the
+// header of the YTS website for the provided term, these being the title, year,
+// slug and image of each movie, this is much faster than SearchMovies and hence
+// suitable for as you type search. An *APIError is returned when the YTS website
+// responds with a status other than StatusOK.
+func (c *Client) QuickSearch(term string) (*QuickSearchResponse, error) {
+	return c.QuickSearchWithContext(context.Background(), term)
+}
+
 type MovieDirectorData struct {
 	Director SiteMovieDirector `json:"director"`
 }
@@ -444,24 +834,12 @@ func (c *Client) MovieDirector(movieSlug string) (*MovieDirectorResponse, error)
 func (c *Client) MovieDirectorWithContext(ctx context.Context, movieSlug string) (
 	*MovieDirectorResponse, error,
 ) {
-	if movieSlug == "" {
-		err := fmt.Errorf("provided movie slug cannot be an empty")
-		return nil, wrapErr(ErrValidationFailure, err)
-	}
-
-	pageURLString := fmt.Sprintf("%s/movies/%s", &c.config.SiteURL, movieSlug)
-	pageURL, _ := url.Parse(pageURLString)
-	document, err := c.newDocumentRequestWithContext(ctx, pageURL)
+	page, err := c.MoviePageWithContext(ctx, movieSlug)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := c.scrapeMovieDirectorData(document)
-	if err != nil {
-		return nil, ErrContentRetrievalFailure
-	}
-
-	return &MovieDirectorResponse{*data}, nil
+	return page.Director()
 }
 
 type MovieReviewsData struct {
@@ -472,6 +850,8 @@ type MovieReviewsData struct {
 // A MovieReviewsResponse contains reviews available for a movie on its YTS page.
 type MovieReviewsResponse struct {
 	Data MovieReviewsData `json:"data"`
+	// The reviews of the movie page omitted from Data as they failed to scrape.
+	ScrapeErrors []*ScrapeError `json:"scrape_errors,omitempty"`
 }
 
 // MovieReviewsWithContext is the same as the MovieReviews method but requires a
@@ -480,30 +860,162 @@ type MovieReviewsResponse struct {
 func (c *Client) MovieReviewsWithContext(ctx context.Context, movieSlug string) (
 	*MovieReviewsResponse, error,
 ) {
-	if movieSlug == "" {
-		err := fmt.Errorf("provided movie slug cannot be an empty")
+	page, err := c.MoviePageWithContext(ctx, movieSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	return page.Reviews()
+}
+
+// MovieReviews method fetches the movie page corresponding to the provided movie
+// slug and scrapes the movie reviews contained therein.
+func (c *Client) MovieReviews(movieSlug string) (*MovieReviewsResponse, error) {
+	return c.MovieReviewsWithContext(context.Background(), movieSlug)
+}
+
+type MovieReviewsPageData struct {
+	ReviewCount int               `json:"review_count"`
+	PageNumber  int               `json:"page_number"`
+	ReviewsMore bool              `json:"reviews_more"`
+	Reviews     []SiteMovieReview `json:"reviews"`
+}
+
+// A MovieReviewsPageResponse contains a single page of the full review listing
+// linked to by the ReviewsMoreLink of a movie page, alongside the total number
+// of reviews reported by the listing.
+type MovieReviewsPageResponse struct {
+	Data MovieReviewsPageData `json:"data"`
+	// The reviews of the listing page omitted from Data as they failed to scrape.
+	ScrapeErrors []*ScrapeError `json:"scrape_errors,omitempty"`
+}
+
+// MovieReviewsPageWithContext is the same as the MovieReviewsPage method but
+// requires a context.Context argument to be passed, this context is then passed
+// to the http.NewRequestWithContext call used for making the network requests.
+func (c *Client) MovieReviewsPageWithContext(ctx context.Context, movieSlug string, page int) (
+	*MovieReviewsPageResponse, error,
+) {
+	if page < 1 {
+		err := fmt.Errorf("provided review page must be at least 1")
 		return nil, wrapErr(ErrValidationFailure, err)
 	}
 
-	pageURLString := fmt.Sprintf("%s/movies/%s", &c.config.SiteURL, movieSlug)
-	pageURL, _ := url.Parse(pageURLString)
-	document, err := c.newDocumentRequestWithContext(ctx, pageURL)
+	moviePage, err := c.MoviePageWithContext(ctx, movieSlug)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := c.scrapeMovieReviewsData(document)
+	return moviePage.ReviewsPageWithContext(ctx, page)
+}
+
+// MovieReviewsPage method fetches the movie page corresponding to the provided
+// movie slug and follows its ReviewsMoreLink to the provided page of the full
+// review listing, see the MoviePage.ReviewsPage method.
+func (c *Client) MovieReviewsPage(movieSlug string, page int) (*MovieReviewsPageResponse, error) {
+	return c.MovieReviewsPageWithContext(context.Background(), movieSlug, page)
+}
+
+type AllMovieReviewsData struct {
+	ReviewCount int               `json:"review_count"`
+	ReviewsMore bool              `json:"reviews_more"`
+	Reviews     []SiteMovieReview `json:"reviews"`
+}
+
+// An AllMovieReviewsResponse contains every review of a movie, these being the
+// reviews shown on its movie page and on every page of the full review listing.
+type AllMovieReviewsResponse struct {
+	Data AllMovieReviewsData `json:"data"`
+	// The reviews of the movie page and of the listing pages omitted from Data as
+	// they failed to scrape.
+	ScrapeErrors []*ScrapeError `json:"scrape_errors,omitempty"`
+}
+
+// AllMovieReviewsWithContext is the same as the AllMovieReviews method but
+// requires a context.Context argument to be passed, this context is then passed
+// to the http.NewRequestWithContext call used for making the network requests.
+func (c *Client) AllMovieReviewsWithContext(ctx context.Context, movieSlug string) (
+	*AllMovieReviewsResponse, error,
+) {
+	moviePage, err := c.MoviePageWithContext(ctx, movieSlug)
 	if err != nil {
-		return nil, ErrContentRetrievalFailure
+		return nil, err
 	}
 
-	return &MovieReviewsResponse{*data}, nil
+	return moviePage.AllReviewsWithContext(ctx)
 }
 
-// MovieReviews method fetches the movie page corresponding to the provided movie
-// slug and scrapes the movie reviews contained therein.
-func (c *Client) MovieReviews(movieSlug string) (*MovieReviewsResponse, error) {
-	return c.MovieReviewsWithContext(context.Background(), movieSlug)
+// AllMovieReviews method fetches the movie page corresponding to the provided movie
+// slug and returns its reviews alongside those of every page of the full review
+// listing linked to by its ReviewsMoreLink, with duplicate reviews removed.
+func (c *Client) AllMovieReviews(movieSlug string) (*AllMovieReviewsResponse, error) {
+	return c.AllMovieReviewsWithContext(context.Background(), movieSlug)
+}
+
+type MovieSiteDetailsData struct {
+	Details SiteMovieDetails `json:"details"`
+}
+
+// A MovieSiteDetailsResponse contains the details of a movie scraped from its YTS
+// page, which include information not provided by the YTS API such as the cast
+// character names, Rotten Tomatoes scores and per torrent tech specs.
+type MovieSiteDetailsResponse struct {
+	Data MovieSiteDetailsData `json:"data"`
+	// The cast members and tech specs omitted from Data as they failed to scrape.
+	ScrapeErrors []*ScrapeError `json:"scrape_errors,omitempty"`
+}
+
+// MovieSiteDetailsWithContext is the same as the MovieSiteDetails method but
+// requires a context.Context argument to be passed, this context is then passed to
+// the http.NewRequestWithContext call used for making the network request.
+func (c *Client) MovieSiteDetailsWithContext(ctx context.Context, movieSlug string) (
+	*MovieSiteDetailsResponse, error,
+) {
+	page, err := c.MoviePageWithContext(ctx, movieSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	return page.SiteDetails()
+}
+
+// MovieSiteDetails method fetches the movie page corresponding to the provided
+// movie slug and scrapes the synopsis, ratings, top cast and tech specs therein.
+func (c *Client) MovieSiteDetails(movieSlug string) (*MovieSiteDetailsResponse, error) {
+	return c.MovieSiteDetailsWithContext(context.Background(), movieSlug)
+}
+
+type SimilarMoviesData struct {
+	Movies []SiteSimilarMovie `json:"movies"`
+}
+
+// A SimilarMoviesResponse contains the movies shown in the "Similar Movies" block
+// of a YTS movie page, which differ from those returned by MovieSuggestions.
+type SimilarMoviesResponse struct {
+	Data SimilarMoviesData `json:"data"`
+	// The similar movies omitted from Data as they failed to scrape.
+	ScrapeErrors []*ScrapeError `json:"scrape_errors,omitempty"`
+}
+
+// SimilarMoviesWithContext is the same as the SimilarMovies method but requires a
+// context.Context argument to be passed, this context is then passed to the
+// http.NewRequestWithContext call used for making the network request.
+func (c *Client) SimilarMoviesWithContext(ctx context.Context, movieSlug string) (
+	*SimilarMoviesResponse, error,
+) {
+	page, err := c.MoviePageWithContext(ctx, movieSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	return page.SimilarMovies()
+}
+
+// SimilarMovies method fetches the movie page corresponding to the provided movie
+// slug and scrapes the movies shown in its "Similar Movies" block, no movies being
+// returned for movie pages which do not show this block.
+func (c *Client) SimilarMovies(movieSlug string) (*SimilarMoviesResponse, error) {
+	return c.SimilarMoviesWithContext(context.Background(), movieSlug)
 }
 
 const movieCommentsPerPage = 30
@@ -517,6 +1029,8 @@ type MovieCommentsData struct {
 // /ajax/comments/{movie_id}?offset={offset} for a movie.
 type MovieCommentsResponse struct {
 	Data MovieCommentsData `json:"data"`
+	// The comments and replies omitted from Data as they failed to scrape.
+	ScrapeErrors []*ScrapeError `json:"scrape_errors,omitempty"`
 }
 
 // MovieCommentsWithContext is the same as the MovieComments method but requires a
@@ -535,41 +1049,12 @@ func (c *Client) MovieCommentsWithContext(ctx context.Context, movieSlug string,
 		return nil, wrapErr(ErrValidationFailure, err)
 	}
 
-	pageURLString := fmt.Sprintf("%s/movies/%s", &c.config.SiteURL, movieSlug)
-	pageURL, _ := url.Parse(pageURLString)
-	pageDoc, err := c.newDocumentRequestWithContext(ctx, pageURL)
-	if err != nil {
-		return nil, err
-	}
-
-	meta, err := c.scrapeMovieCommentsMetaData(pageDoc)
-	if err != nil {
-		return nil, ErrContentRetrievalFailure
-	}
-
-	var (
-		offset = (page - 1) * movieCommentsPerPage
-		isLast = meta.commentCount-offset <= movieCommentsPerPage
-	)
-
-	commentURLString := c.getCommentsURL(meta.movieID, offset)
-	commentURL, _ := url.Parse(commentURLString)
-	commentDoc, err := c.newDocumentRequestWithContext(ctx, commentURL)
+	moviePage, err := c.MoviePageWithContext(ctx, movieSlug)
 	if err != nil {
 		return nil, err
 	}
 
-	comments, err := c.scrapeMovieComments(commentDoc)
-	if err != nil {
-		return nil, ErrContentRetrievalFailure
-	}
-
-	data := MovieCommentsData{
-		CommentsMore: !isLast,
-		Comments:     comments,
-	}
-
-	return &MovieCommentsResponse{data}, nil
+	return moviePage.CommentsWithContext(ctx, page)
 }
 
 // MovieComments method fetches the comments for the provided movie slug, the method
@@ -584,12 +1069,16 @@ type MovieAdditionalDetailsData struct {
 	Comments        []SiteMovieComment `json:"comments"`
 	Reviews         []SiteMovieReview  `json:"reviews"`
 	ReviewsMoreLink string             `json:"reviews_more_link"`
+	SimilarMovies   []SiteSimilarMovie `json:"similar_movies"`
 }
 
-// A MovieAdditionalDetailsResponse contains the movie directory, reviews and
-// the initial comments available on the movie page.
+// A MovieAdditionalDetailsResponse contains the movie directory, reviews, similar
+// movies and the initial comments available on the movie page.
 type MovieAdditionalDetailsResponse struct {
 	Data MovieAdditionalDetailsData `json:"data"`
+	// The reviews, similar movies and comments omitted from Data as they failed
+	// to scrape.
+	ScrapeErrors []*ScrapeError `json:"scrape_errors,omitempty"`
 }
 
 // MovieAdditionalDetailsWithContext is the same as the MovieAdditionalDetails
@@ -598,58 +1087,28 @@ type MovieAdditionalDetailsResponse struct {
 func (c *Client) MovieAdditionalDetailsWithContext(ctx context.Context, movieSlug string) (
 	*MovieAdditionalDetailsResponse, error,
 ) {
-	if movieSlug == "" {
-		err := fmt.Errorf("provided movie slug cannot be an empty")
-		return nil, wrapErr(ErrValidationFailure, err)
-	}
-
-	pageURLString := fmt.Sprintf("%s/movies/%s", &c.config.SiteURL, movieSlug)
-	pageURL, _ := url.Parse(pageURLString)
-	pageDocument, err := c.newDocumentRequestWithContext(ctx, pageURL)
+	page, err := c.MoviePageWithContext(ctx, movieSlug)
 	if err != nil {
 		return nil, err
 	}
 
-	var (
-		dData, dErr = c.scrapeMovieDirectorData(pageDocument)
-		rData, rErr = c.scrapeMovieReviewsData(pageDocument)
-		cData, mErr = c.scrapeMovieCommentsMetaData(pageDocument)
-	)
-
-	if v := errors.Join(dErr, rErr, mErr); v != nil {
-		debug.Println(v)
-		return nil, ErrContentRetrievalFailure
-	}
-
-	commentURLString := c.getCommentsURL(cData.movieID, 0)
-	commentURL, _ := url.Parse(commentURLString)
-	commentDoc, err := c.newDocumentRequestWithContext(ctx, commentURL)
-	if err != nil {
-		return nil, err
-	}
-
-	comments, cErr := c.scrapeMovieComments(commentDoc)
-	if cErr != nil {
-		return nil, ErrContentRetrievalFailure
-	}
-
-	data := MovieAdditionalDetailsData{
-		Comments:        comments,
-		Director:        dData.Director,
-		Reviews:         rData.Reviews,
-		ReviewsMoreLink: rData.ReviewsMoreLink,
-	}
-
-	return &MovieAdditionalDetailsResponse{data}, nil
+	return page.AdditionalDetailsWithContext(ctx)
 }
 
 // MovieAdditionalDetails client method fetches the movie page for the provided
-// movie slug and scrapes the director, reviews and initial comments provided on
-// the movie page.
+// movie slug and scrapes the director, reviews, similar movies and initial comments
+// provided on the movie page.
 func (c *Client) MovieAdditionalDetails(movieSlug string) (*MovieAdditionalDetailsResponse, error) {
 	return c.MovieAdditionalDetailsWithContext(context.Background(), movieSlug)
 }
 
+func (c *Client) torrentBrand() string {
+	if c.config.TorrentBrand != "" {
+		return c.config.TorrentBrand
+	}
+	return strings.ToUpper(c.config.SiteURL.Host)
+}
+
 // A TorrentMagnets is the return type of MagnetLinks method of a `yts.Client`
 type TorrentMagnets map[Quality]string
 
@@ -668,7 +1127,7 @@ func (c *Client) MagnetLinks(t TorrentInfoGetter) TorrentMagnets {
 			"%s+[%s]+[%s]",
 			t.GetTorrentInfo().MovieTitle,
 			torrent.Quality,
-			strings.ToUpper(c.config.SiteURL.Host),
+			c.torrentBrand(),
 		)
 
 		return fmt.Sprintf(
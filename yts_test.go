@@ -10,6 +10,7 @@ import (
 	"path"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -54,11 +55,16 @@ func TestDefaultClientConfig(t *testing.T) {
 
 	got := yts.DefaultClientConfig()
 	want := yts.ClientConfig{
-		APIBaseURL:      *parsedAPIBaseURL,
-		SiteURL:         *parsedSiteURL,
-		RequestTimeout:  time.Minute,
-		TorrentTrackers: yts.DefaultTorrentTrackers(),
-		Debug:           false,
+		APIBaseURL:          *parsedAPIBaseURL,
+		SiteURL:             *parsedSiteURL,
+		RequestTimeout:      time.Minute,
+		MirrorCooldown:      yts.DefaultMirrorCooldown,
+		RetryPolicy:         yts.DefaultRetryPolicy(),
+		OffSiteRateLimit:    yts.DefaultOffSiteRateLimit(),
+		ConditionalRequests: true,
+		TorrentTrackers:     yts.DefaultTorrentTrackers(),
+		Selectors:           yts.DefaultSelectors(),
+		Debug:               false,
 	}
 
 	assertEqual(t, "DefaultClientConfig", got, want)
@@ -115,6 +121,11 @@ type testHTTPHandlerConfig struct {
 	filename   string
 	pattern    string
 	statusCode int
+	query      url.Values
+	delay      time.Duration
+	counter    *testRequestCounter
+	statuses   *testStatusSequence
+	header     http.Header
 }
 
 func defaultHandlerConfig(t *testing.T, pattern, dir, filename string) testHTTPHandlerConfig {
@@ -134,25 +145,169 @@ func handlerConfigWithStatusCode(t *testing.T, pattern string, statusCode int) t
 	}
 }
 
+// pagedHandlerConfigs serves the provided pages found in the provided directory,
+// the page selected by the value of the param query parameter being read from the
+// file named using the format, and responds with http.StatusNotFound otherwise.
+func pagedHandlerConfigs(
+	t *testing.T, pattern, dir, param, format string, counter *testRequestCounter, pages ...int,
+) []testHTTPHandlerConfig {
+	t.Helper()
+	configs := make([]testHTTPHandlerConfig, 0, len(pages)+1)
+	for _, page := range pages {
+		handlerCfg := defaultHandlerConfig(t, pattern, dir, fmt.Sprintf(format, page))
+		configs = append(configs, handlerCfg.withQuery(fmt.Sprintf("%s=%d", param, page)).withCounter(counter))
+	}
+
+	notFoundCfg := handlerConfigWithStatusCode(t, pattern, http.StatusNotFound)
+	return append(configs, notFoundCfg.withCounter(counter))
+}
+
+// withQuery restricts the handler to the requests with the provided query values,
+// the handlers sharing a pattern being tried in the order they were provided.
+func (cfg testHTTPHandlerConfig) withQuery(query string) testHTTPHandlerConfig {
+	cfg.query, _ = url.ParseQuery(query)
+	return cfg
+}
+
+func (cfg testHTTPHandlerConfig) withDelay(delay time.Duration) testHTTPHandlerConfig {
+	cfg.delay = delay
+	return cfg
+}
+
+func (cfg testHTTPHandlerConfig) withCounter(counter *testRequestCounter) testHTTPHandlerConfig {
+	cfg.counter = counter
+	return cfg
+}
+
+// withStatusSequence responds to the first requests with the provided status codes
+// in order, and with the status code of the config once they have been used up.
+func (cfg testHTTPHandlerConfig) withStatusSequence(statusCodes ...int) testHTTPHandlerConfig {
+	cfg.statuses = &testStatusSequence{statusCodes: statusCodes}
+	return cfg
+}
+
+func (cfg testHTTPHandlerConfig) withHeader(key, value string) testHTTPHandlerConfig {
+	cfg.header = cfg.header.Clone()
+	if cfg.header == nil {
+		cfg.header = make(http.Header)
+	}
+	cfg.header.Add(key, value)
+	return cfg
+}
+
+func (cfg *testHTTPHandlerConfig) matches(r *http.Request) bool {
+	query := r.URL.Query()
+	for key := range cfg.query {
+		if query.Get(key) != cfg.query.Get(key) {
+			return false
+		}
+	}
+	return true
+}
+
+func (cfg *testHTTPHandlerConfig) serve(w http.ResponseWriter, r *http.Request) {
+	if cfg.counter != nil {
+		cfg.counter.begin()
+		defer cfg.counter.end()
+	}
+	time.Sleep(cfg.delay)
+
+	for key, values := range cfg.header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+
+	statusCode := cfg.statusCode
+	if cfg.statuses != nil {
+		statusCode = cfg.statuses.next(statusCode)
+	}
+
+	switch statusCode {
+	case http.StatusOK:
+		mockPath := path.Join("testdata", cfg.filename)
+		http.ServeFile(w, r, mockPath)
+	default:
+		w.WriteHeader(statusCode)
+		fmt.Fprintf(w, "status_code: %d", statusCode)
+	}
+}
+
+// testStatusSequence hands out the status codes it holds in order, it is shared
+// by the copies of the config it belongs to.
+type testStatusSequence struct {
+	statusCodes []int
+	served      atomic.Int32
+}
+
+func (ss *testStatusSequence) next(fallback int) int {
+	index := int(ss.served.Add(1)) - 1
+	if index < len(ss.statusCodes) {
+		return ss.statusCodes[index]
+	}
+	return fallback
+}
+
+// testRequestCounter counts the requests served by the handlers it is passed to,
+// as well as the maximum number of requests served concurrently.
+type testRequestCounter struct {
+	requests    atomic.Int32
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (rc *testRequestCounter) begin() {
+	rc.requests.Add(1)
+	current := rc.inFlight.Add(1)
+	for {
+		previous := rc.maxInFlight.Load()
+		if current <= previous || rc.maxInFlight.CompareAndSwap(previous, current) {
+			return
+		}
+	}
+}
+
+func (rc *testRequestCounter) end() {
+	rc.inFlight.Add(-1)
+}
+
 func createTestServer(t *testing.T, configs ...testHTTPHandlerConfig) *httptest.Server {
 	t.Helper()
-	serveMux := &http.ServeMux{}
+	byPattern := make(map[string][]testHTTPHandlerConfig)
 	for _, config := range configs {
-		cfg := config
-		serveMux.HandleFunc(cfg.pattern, func(w http.ResponseWriter, r *http.Request) {
-			switch cfg.statusCode {
-			case http.StatusOK:
-				mockPath := path.Join("testdata", cfg.filename)
-				http.ServeFile(w, r, mockPath)
-			default:
-				w.WriteHeader(cfg.statusCode)
-				fmt.Fprintf(w, "status_code: %d", cfg.statusCode)
+		byPattern[config.pattern] = append(byPattern[config.pattern], config)
+	}
+
+	serveMux := &http.ServeMux{}
+	for pattern, patternConfigs := range byPattern {
+		cfgs := patternConfigs
+		serveMux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			for i := range cfgs {
+				if cfgs[i].matches(r) {
+					cfgs[i].serve(w, r)
+					return
+				}
 			}
+			http.NotFound(w, r)
 		})
 	}
 	return httptest.NewServer(serveMux)
 }
 
+// serverRoundTripper sends every request to the provided test server regardless of
+// its host, allowing pages hosted outside the YTS website to be served in tests.
+func serverRoundTripper(t *testing.T, server *httptest.Server) yts.RoundTripperFunc {
+	t.Helper()
+	serverURL, _ := url.Parse(server.URL)
+	return func(r *http.Request) (*http.Response, error) {
+		redirected := r.Clone(r.Context())
+		redirected.URL.Scheme = serverURL.Scheme
+		redirected.URL.Host = serverURL.Host
+		redirected.Host = serverURL.Host
+		return http.DefaultTransport.RoundTrip(redirected)
+	}
+}
+
 func TestClient_SearchMoviesWithContext(t *testing.T) {
 	const (
 		queryTerm   = "Oppenheimer (2023)"
@@ -191,6 +346,10 @@ func TestClient_SearchMoviesWithContext(t *testing.T) {
 	}
 
 	mockedOKResponse := &yts.SearchMoviesResponse{
+		BaseResponse: yts.BaseResponse{
+			Status:        yts.StatusOK,
+			StatusMessage: "Query was successful",
+		},
 		Data: yts.SearchMoviesData{
 			MovieCount: 3,
 			PageNumber: 1,
@@ -297,6 +456,14 @@ func TestClient_SearchMoviesWithContext(t *testing.T) {
 			filters:    validSearchFilters,
 			wantErr:    yts.ErrUnexpectedHTTPResponseStatus,
 		},
+		{
+			name:       `returns error when response status is "error"`,
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "status_error.json"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			filters:    validSearchFilters,
+			wantErr:    &yts.APIError{Status: "error", StatusMessage: "Invalid query parameters"},
+		},
 		{
 			name:       "returns mocked ok response for default filters",
 			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.json"),
@@ -346,6 +513,10 @@ func TestClient_MovieDetailsWithContext(t *testing.T) {
 	defer cancel()
 
 	mockedOKResponse := &yts.MovieDetailsResponse{
+		BaseResponse: yts.BaseResponse{
+			Status:        yts.StatusOK,
+			StatusMessage: "Query was successful",
+		},
 		Data: yts.MovieDetailsData{
 			Movie: yts.MovieDetails{
 				MoviePartial: yts.MoviePartial{ID: movieID},
@@ -395,6 +566,24 @@ func TestClient_MovieDetailsWithContext(t *testing.T) {
 			filters:    yts.DefaultMovieDetailsFilters(),
 			wantErr:    yts.ErrUnexpectedHTTPResponseStatus,
 		},
+		{
+			name:       `returns error when response status is "error"`,
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "status_error.json"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieID:    movieID,
+			filters:    yts.DefaultMovieDetailsFilters(),
+			wantErr:    &yts.APIError{Status: "error", StatusMessage: "Invalid query parameters"},
+		},
+		{
+			name:       "returns error when response contains movie with zero ID",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "movie_not_found.json"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieID:    movieID,
+			filters:    yts.DefaultMovieDetailsFilters(),
+			wantErr:    yts.ErrMovieNotFound,
+		},
 		{
 			name:       "returns mocked ok response for valid movieID",
 			movieID:    movieID,
@@ -423,6 +612,194 @@ func TestClient_MovieDetailsWithContext(t *testing.T) {
 	}
 }
 
+func TestClient_MovieDetailsByIMDbWithContext(t *testing.T) {
+	const (
+		imdbCode    = "tt15398776"
+		movieID     = 57427
+		methodName  = "Client.MovieDetailsByIMDb"
+		testdataDir = "movie_details"
+		pattern     = "movie_details.json"
+	)
+
+	timedoutCtx, cancel := context.WithDeadline(
+		context.Background(), time.Now(),
+	)
+	defer cancel()
+
+	mockedOKResponse := &yts.MovieDetailsResponse{
+		BaseResponse: yts.BaseResponse{
+			Status:        yts.StatusOK,
+			StatusMessage: "Query was successful",
+		},
+		Data: yts.MovieDetailsData{
+			Movie: yts.MovieDetails{
+				MoviePartial: yts.MoviePartial{ID: movieID},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		handlerCfg testHTTPHandlerConfig
+		clientCfg  yts.ClientConfig
+		ctx        context.Context
+		imdbCode   string
+		filters    *yts.MovieDetailsFilters
+		want       *yts.MovieDetailsResponse
+		wantErr    error
+	}{
+		{
+			name:      "returns error for empty imdbCode",
+			clientCfg: yts.DefaultClientConfig(),
+			ctx:       context.Background(),
+			imdbCode:  "",
+			filters:   yts.DefaultMovieDetailsFilters(),
+			wantErr:   yts.ErrValidationFailure,
+		},
+		{
+			name:      `returns error for imdbCode without "tt" prefix`,
+			clientCfg: yts.DefaultClientConfig(),
+			ctx:       context.Background(),
+			imdbCode:  "15398776",
+			filters:   yts.DefaultMovieDetailsFilters(),
+			wantErr:   yts.ErrValidationFailure,
+		},
+		{
+			name:      "returns error for imdbCode with too few digits",
+			clientCfg: yts.DefaultClientConfig(),
+			ctx:       context.Background(),
+			imdbCode:  "tt153987",
+			filters:   yts.DefaultMovieDetailsFilters(),
+			wantErr:   yts.ErrValidationFailure,
+		},
+		{
+			name:      "returns error for imdbCode with too many digits",
+			clientCfg: yts.DefaultClientConfig(),
+			ctx:       context.Background(),
+			imdbCode:  "tt153987760",
+			filters:   yts.DefaultMovieDetailsFilters(),
+			wantErr:   yts.ErrValidationFailure,
+		},
+		{
+			name:      "returns error when request context times out",
+			clientCfg: yts.DefaultClientConfig(),
+			ctx:       timedoutCtx,
+			imdbCode:  imdbCode,
+			filters:   yts.DefaultMovieDetailsFilters(),
+			wantErr:   context.DeadlineExceeded,
+		},
+		{
+			name:       "returns error when response status is outside 2.x.x range",
+			handlerCfg: handlerConfigWithStatusCode(t, pattern, http.StatusNotFound),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			imdbCode:   imdbCode,
+			filters:    yts.DefaultMovieDetailsFilters(),
+			wantErr:    yts.ErrUnexpectedHTTPResponseStatus,
+		},
+		{
+			name:       "returns error when response contains movie with zero ID",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "movie_not_found.json"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			imdbCode:   imdbCode,
+			filters:    yts.DefaultMovieDetailsFilters(),
+			wantErr:    yts.ErrMovieNotFound,
+		},
+		{
+			name:       "returns mocked ok response for valid imdbCode",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.json"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			imdbCode:   imdbCode,
+			filters:    yts.DefaultMovieDetailsFilters(),
+			want:       mockedOKResponse,
+		},
+		{
+			name:       "returns mocked ok response for valid 7 digit imdbCode",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.json"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			imdbCode:   "tt0816692",
+			filters:    yts.DefaultMovieDetailsFilters(),
+			want:       mockedOKResponse,
+		},
+	}
+	for _, tt := range tests {
+		clientCfg := tt.clientCfg
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.handlerCfg.pattern != "" {
+				server := createTestServer(t, tt.handlerCfg)
+				serverURL, _ := url.Parse(server.URL)
+				clientCfg.APIBaseURL = *serverURL
+				defer server.Close()
+			}
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			got, err := c.MovieDetailsByIMDbWithContext(tt.ctx, tt.imdbCode, tt.filters)
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, got, tt.want)
+		})
+	}
+}
+
+func TestClient_ResolveIMDbToIDWithContext(t *testing.T) {
+	const (
+		imdbCode    = "tt15398776"
+		methodName  = "Client.ResolveIMDbToID"
+		testdataDir = "movie_details"
+	)
+
+	serveMux := &http.ServeMux{}
+	serveMux.HandleFunc("/movie_details.json", func(w http.ResponseWriter, r *http.Request) {
+		file := "movie_not_found.json"
+		if r.URL.Query().Get("imdb_id") == imdbCode {
+			file = "ok_response.json"
+		}
+		http.ServeFile(w, r, path.Join("testdata", testdataDir, file))
+	})
+
+	server := httptest.NewServer(serveMux)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.APIBaseURL = *serverURL
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+
+	tests := []struct {
+		name     string
+		imdbCode string
+		want     int
+		wantErr  error
+	}{
+		{
+			name:     "returns error for invalid imdbCode",
+			imdbCode: "nm0634240",
+			want:     0,
+			wantErr:  yts.ErrValidationFailure,
+		},
+		{
+			name:     "returns error when no movie is found for imdbCode",
+			imdbCode: "tt0816692",
+			want:     0,
+			wantErr:  yts.ErrMovieNotFound,
+		},
+		{
+			name:     "returns movieID of the movie found for imdbCode",
+			imdbCode: imdbCode,
+			want:     57427,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ResolveIMDbToIDWithContext(context.Background(), tt.imdbCode)
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, got, tt.want)
+		})
+	}
+}
+
 func TestClient_MovieSuggestionsWithContext(t *testing.T) {
 	const (
 		movieID     = 57427
@@ -437,6 +814,10 @@ func TestClient_MovieSuggestionsWithContext(t *testing.T) {
 	defer cancel()
 
 	mockedOKResponse := &yts.MovieSuggestionsResponse{
+		BaseResponse: yts.BaseResponse{
+			Status:        yts.StatusOK,
+			StatusMessage: "Query was successful",
+		},
 		Data: yts.MovieSuggestionsData{
 			MovieCount: 0,
 			Movies: []yts.Movie{
@@ -485,6 +866,14 @@ func TestClient_MovieSuggestionsWithContext(t *testing.T) {
 			movieID:    movieID,
 			wantErr:    yts.ErrUnexpectedHTTPResponseStatus,
 		},
+		{
+			name:       `returns error when response status is "error"`,
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "status_error.json"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieID:    movieID,
+			wantErr:    &yts.APIError{Status: "error", StatusMessage: "Invalid query parameters"},
+		},
 		{
 			name:       "returns mocked ok response for valid movieID",
 			clientCfg:  yts.DefaultClientConfig(),
@@ -795,45 +1184,335 @@ func TestClient_HomePageContentWithContext(t *testing.T) {
 	}
 }
 
-func TestClient_ResolveMovieSlugToIDWithContext(t *testing.T) {
+func TestClient_BrowseMoviesWithContext(t *testing.T) {
 	const (
-		methodName  = "Client.ResolveMovieSlugtoID"
-		testdataDir = "resolve_movie_slug"
-		movieID     = 3175
-		movieSlug   = "the-dark-knight-2008"
-		pattern     = "/movies/the-dark-knight-2008"
+		methodName  = "Client.BrowseMovies"
+		testdataDir = "browse_movies"
+		pattern     = "/"
+		filtersPath = "/browse-movies/oppenheimer nolan/1080p/drama/7/rating/2020-2024/en"
+	)
+
+	timedoutCtx, cancel := context.WithDeadline(
+		context.Background(), time.Now(),
 	)
+	defer cancel()
+
+	validBrowseFilters := &yts.BrowseMoviesFilters{
+		Keyword:       "oppenheimer nolan",
+		Quality:       yts.Quality1080p,
+		Genre:         yts.GenreDrama,
+		MinimumRating: 7,
+		Order:         yts.OrderRating,
+		Year:          yts.YearRange(2020, 2024),
+		Language:      yts.LanguageEnglish,
+		Page:          1,
+	}
+
+	invalidBrowseFilters := func(modify func(f *yts.BrowseMoviesFilters)) *yts.BrowseMoviesFilters {
+		filters := yts.DefaultBrowseMoviesFilters("")
+		modify(filters)
+		return filters
+	}
+
+	mockedOKResponse := &yts.BrowseMoviesResponse{
+		Data: yts.BrowseMoviesData{
+			MovieCount: 1224,
+			PageNumber: 2,
+			PageCount:  62,
+			Movies: []yts.SiteMovie{
+				{
+					Rating: "8.3 / 10",
+					SiteMovieBase: yts.SiteMovieBase{
+						Title:  "Oppenheimer",
+						Year:   2023,
+						Link:   "https://yts.mx/movies/oppenheimer-2023",
+						Image:  "/assets/images/movies/oppenheimer_2023/medium-cover.jpg",
+						Genres: []yts.Genre{"Drama", "History"},
+					},
+				},
+				{
+					Rating: "7.4 / 10",
+					SiteMovieBase: yts.SiteMovieBase{
+						Title:  "The Zone of Interest",
+						Year:   2023,
+						Link:   "https://yts.mx/movies/the-zone-of-interest-2023",
+						Image:  "/assets/images/movies/the_zone_of_interest_2023/medium-cover.jpg",
+						Genres: []yts.Genre{"Drama", "History"},
+					},
+				},
+			},
+		},
+	}
+
+	mockedNoResultsResponse := &yts.BrowseMoviesResponse{
+		Data: yts.BrowseMoviesData{
+			MovieCount: 0,
+			PageNumber: 1,
+			PageCount:  0,
+			Movies:     []yts.SiteMovie{},
+		},
+	}
 
 	tests := []struct {
 		name       string
 		handlerCfg testHTTPHandlerConfig
-		clientCfg  yts.ClientConfig
+		filters    *yts.BrowseMoviesFilters
 		ctx        context.Context
-		movieSlug  string
-		want       int
+		want       *yts.BrowseMoviesResponse
 		wantErr    error
 	}{
 		{
-			name:       "resolves movie slug to ID successfully when available",
+			name:       "returns error when invalid quality is provided",
 			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
-			clientCfg:  yts.DefaultClientConfig(),
+			filters:    invalidBrowseFilters(func(f *yts.BrowseMoviesFilters) { f.Quality = "4K" }),
 			ctx:        context.Background(),
-			movieSlug:  "the-dark-knight-2008",
-			want:       movieID,
+			wantErr:    yts.ErrFilterValidationFailure,
 		},
 		{
-			name:       "returns error when required selector is not available",
-			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "missing_selector.html"),
-			clientCfg:  yts.DefaultClientConfig(),
+			name:       "returns error when invalid year is provided",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
+			filters:    invalidBrowseFilters(func(f *yts.BrowseMoviesFilters) { f.Year = "20-24" }),
 			ctx:        context.Background(),
-			movieSlug:  "the-dark-knight-2008",
-			want:       0,
-			wantErr:    yts.ErrContentRetrievalFailure,
+			wantErr:    yts.ErrFilterValidationFailure,
 		},
 		{
-			name:       "returns error if fails to pass available movie ID",
-			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "invalid_id.html"),
-			clientCfg:  yts.DefaultClientConfig(),
+			name:       "returns error when invalid language is provided",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
+			filters:    invalidBrowseFilters(func(f *yts.BrowseMoviesFilters) { f.Language = "English" }),
+			ctx:        context.Background(),
+			wantErr:    yts.ErrFilterValidationFailure,
+		},
+		{
+			name:       "returns error when invalid order is provided",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
+			filters:    invalidBrowseFilters(func(f *yts.BrowseMoviesFilters) { f.Order = "newest" }),
+			ctx:        context.Background(),
+			wantErr:    yts.ErrFilterValidationFailure,
+		},
+		{
+			name:       "returns error when movie count selector missing",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "missing_movie_count.html"),
+			filters:    yts.DefaultBrowseMoviesFilters(""),
+			ctx:        context.Background(),
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when scraped movie count is invalid",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "invalid_movie_count.html"),
+			filters:    yts.DefaultBrowseMoviesFilters(""),
+			ctx:        context.Background(),
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       `returns error when scraped "Rating" is invalid`,
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "invalid_rating.html"),
+			filters:    yts.DefaultBrowseMoviesFilters(""),
+			ctx:        context.Background(),
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when request context times out",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
+			filters:    yts.DefaultBrowseMoviesFilters(""),
+			ctx:        timedoutCtx,
+			wantErr:    context.DeadlineExceeded,
+		},
+		{
+			name:       "returns error when response status is outside 2.x.x range",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "non_existent.html"),
+			filters:    yts.DefaultBrowseMoviesFilters(""),
+			ctx:        context.Background(),
+			wantErr:    yts.ErrUnexpectedHTTPResponseStatus,
+		},
+		{
+			name:       "returns empty response when no movies are found",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "no_results.html"),
+			filters:    yts.DefaultBrowseMoviesFilters("non-existent"),
+			ctx:        context.Background(),
+			want:       mockedNoResultsResponse,
+		},
+		{
+			name:       "returns mocked ok response when page for filters is requested",
+			handlerCfg: defaultHandlerConfig(t, filtersPath, testdataDir, "ok_response.html"),
+			filters:    validBrowseFilters,
+			ctx:        context.Background(),
+			want:       mockedOKResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := createTestServer(t, tt.handlerCfg)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.SiteURL = *serverURL
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			got, err := c.BrowseMoviesWithContext(tt.ctx, tt.filters)
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, got, tt.want)
+		})
+	}
+}
+
+func TestClient_QuickSearchWithContext(t *testing.T) {
+	const (
+		methodName  = "Client.QuickSearch"
+		testdataDir = "quick_search"
+		pattern     = "ajax/search"
+		term        = "oppenheimer"
+	)
+
+	timedoutCtx, cancel := context.WithDeadline(
+		context.Background(), time.Now(),
+	)
+	defer cancel()
+
+	cancelledCtx, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+
+	mockedOKResponse := &yts.QuickSearchResponse{
+		Data: yts.QuickSearchData{
+			Results: []yts.QuickSearchResult{
+				{
+					Title: "Oppenheimer",
+					Year:  2023,
+					Slug:  "oppenheimer-2023",
+					Link:  "https://yts.mx/movies/oppenheimer-2023",
+					Image: "https://yts.mx/assets/images/movies/oppenheimer_2023/small-cover.jpg",
+				},
+				{
+					Title: "The Trial of J. Robert Oppenheimer",
+					Year:  2009,
+					Slug:  "the-trial-of-j-robert-oppenheimer-2009",
+					Link:  "https://yts.mx/movies/the-trial-of-j-robert-oppenheimer-2009",
+					Image: "https://yts.mx/assets/images/movies/the_trial_of_j_robert_oppenheimer_2009/small-cover.jpg",
+				},
+			},
+		},
+	}
+
+	mockedNoResultsResponse := &yts.QuickSearchResponse{
+		Data: yts.QuickSearchData{
+			Results: []yts.QuickSearchResult{},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		handlerCfg testHTTPHandlerConfig
+		ctx        context.Context
+		term       string
+		want       *yts.QuickSearchResponse
+		wantErr    error
+	}{
+		{
+			name:    "returns error for empty search term",
+			ctx:     context.Background(),
+			term:    "  ",
+			wantErr: yts.ErrValidationFailure,
+		},
+		{
+			name:       "returns error when request context is cancelled",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.json"),
+			ctx:        cancelledCtx,
+			term:       term,
+			wantErr:    context.Canceled,
+		},
+		{
+			name:       "returns error when request context times out",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.json"),
+			ctx:        timedoutCtx,
+			term:       term,
+			wantErr:    context.DeadlineExceeded,
+		},
+		{
+			name:       "returns error when response status is outside 2.x.x range",
+			handlerCfg: handlerConfigWithStatusCode(t, pattern, http.StatusNotFound),
+			ctx:        context.Background(),
+			term:       term,
+			wantErr:    yts.ErrUnexpectedHTTPResponseStatus,
+		},
+		{
+			name:       `returns error when response status is not "ok"`,
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "status_error.json"),
+			ctx:        context.Background(),
+			term:       term,
+			wantErr:    &yts.APIError{Status: "fail", StatusMessage: "Search failed"},
+		},
+		{
+			name:       "returns empty response when no movies are found",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "no_results.json"),
+			ctx:        context.Background(),
+			term:       term,
+			want:       mockedNoResultsResponse,
+		},
+		{
+			name:       "returns mocked ok response for valid search term",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.json"),
+			ctx:        context.Background(),
+			term:       term,
+			want:       mockedOKResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientCfg := yts.DefaultClientConfig()
+			if tt.handlerCfg.pattern != "" {
+				server := createTestServer(t, tt.handlerCfg)
+				serverURL, _ := url.Parse(server.URL)
+				clientCfg.SiteURL = *serverURL
+				defer server.Close()
+			}
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			got, err := c.QuickSearchWithContext(tt.ctx, tt.term)
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, got, tt.want)
+		})
+	}
+}
+
+func TestClient_ResolveMovieSlugToIDWithContext(t *testing.T) {
+	const (
+		methodName  = "Client.ResolveMovieSlugtoID"
+		testdataDir = "resolve_movie_slug"
+		movieID     = 3175
+		movieSlug   = "the-dark-knight-2008"
+		pattern     = "/movies/the-dark-knight-2008"
+	)
+
+	tests := []struct {
+		name       string
+		handlerCfg testHTTPHandlerConfig
+		clientCfg  yts.ClientConfig
+		ctx        context.Context
+		movieSlug  string
+		want       int
+		wantErr    error
+	}{
+		{
+			name:       "resolves movie slug to ID successfully when available",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  "the-dark-knight-2008",
+			want:       movieID,
+		},
+		{
+			name:       "returns error when required selector is not available",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "missing_selector.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  "the-dark-knight-2008",
+			want:       0,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error if fails to pass available movie ID",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "invalid_id.html"),
+			clientCfg:  yts.DefaultClientConfig(),
 			ctx:        context.Background(),
 			movieSlug:  "the-dark-knight-2008",
 			want:       0,
@@ -1083,6 +1762,14 @@ func TestClient_MoviesReviewsWithContext(t *testing.T) {
 			movieSlug:  movieSlug,
 			wantErr:    yts.ErrContentRetrievalFailure,
 		},
+		{
+			name:       "returns error when more reviews URL is empty",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "empty_reviews_more_url.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
 		{
 			name:       "returns error when request context times out",
 			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
@@ -1127,6 +1814,232 @@ func TestClient_MoviesReviewsWithContext(t *testing.T) {
 	}
 }
 
+func TestClient_MovieReviewsPageWithContext(t *testing.T) {
+	const (
+		methodName  = "Client.MovieReviewsPage"
+		testdataDir = "movie_reviews_pages"
+		movieSlug   = "oppenheimer-2023"
+		firstPage   = "reviews_page_1.html"
+		secondPage  = "reviews_page_2.html"
+		pageOnePath = "title/tt15398776/reviews"
+		pageTwoPath = "title/tt15398776/reviews/_ajax"
+	)
+
+	var (
+		reviewOne  = yts.SiteMovieReview{Author: "claszdsburrogato", Title: "title-one", Content: "content-one", Rating: "7/10"}
+		reviewFour = yts.SiteMovieReview{Author: "author-four", Title: "title-four", Content: "content-four", Rating: "9/10"}
+		reviewFive = yts.SiteMovieReview{Author: "author-five", Title: "title-five", Content: "content-five", Rating: "8/10"}
+		reviewSix  = yts.SiteMovieReview{Author: "author-six", Title: "title-six", Content: "content-six", Rating: "6/10"}
+	)
+
+	tests := []struct {
+		name      string
+		firstPage string
+		page      int
+		want      *yts.MovieReviewsPageResponse
+		wantErr   error
+	}{
+		{
+			name:      "returns error for page less than 1",
+			firstPage: firstPage,
+			page:      0,
+			wantErr:   yts.ErrValidationFailure,
+		},
+		{
+			name:      "returns error for page past MaxReviewsPages",
+			firstPage: firstPage,
+			page:      yts.MaxReviewsPages + 1,
+			wantErr:   yts.ErrValidationFailure,
+		},
+		{
+			name:      "returns error when review count is invalid",
+			firstPage: "invalid_review_count.html",
+			page:      1,
+			wantErr:   yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:      `returns error when scraped "Rating" is invalid`,
+			firstPage: "invalid_review_rating.html",
+			page:      1,
+			wantErr:   yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:      "returns first page of reviews",
+			firstPage: firstPage,
+			page:      1,
+			want: &yts.MovieReviewsPageResponse{
+				Data: yts.MovieReviewsPageData{
+					ReviewCount: 6,
+					PageNumber:  1,
+					ReviewsMore: true,
+					Reviews:     []yts.SiteMovieReview{reviewOne, reviewFour, reviewFive},
+				},
+			},
+		},
+		{
+			name:      "returns second page of reviews following the more reviews link",
+			firstPage: firstPage,
+			page:      2,
+			want: &yts.MovieReviewsPageResponse{
+				Data: yts.MovieReviewsPageData{
+					ReviewCount: 6,
+					PageNumber:  2,
+					ReviewsMore: false,
+					Reviews:     []yts.SiteMovieReview{reviewFive, reviewSix},
+				},
+			},
+		},
+		{
+			name:      "returns empty page of reviews past the last page",
+			firstPage: firstPage,
+			page:      3,
+			want: &yts.MovieReviewsPageResponse{
+				Data: yts.MovieReviewsPageData{
+					ReviewCount: 6,
+					PageNumber:  3,
+					ReviewsMore: false,
+					Reviews:     []yts.SiteMovieReview{},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := createTestServer(
+				t,
+				defaultHandlerConfig(t, path.Join("movies", movieSlug), testdataDir, "movie_page.html"),
+				defaultHandlerConfig(t, pageOnePath, testdataDir, tt.firstPage),
+				defaultHandlerConfig(t, pageTwoPath, testdataDir, secondPage),
+			)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.SiteURL = *serverURL
+			clientCfg.Transport = serverRoundTripper(t, server)
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			got, err := c.MovieReviewsPageWithContext(context.Background(), movieSlug, tt.page)
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, got, tt.want)
+		})
+	}
+}
+
+func TestClient_AllMovieReviewsWithContext(t *testing.T) {
+	const (
+		methodName  = "Client.AllMovieReviews"
+		testdataDir = "movie_reviews_pages"
+		movieSlug   = "oppenheimer-2023"
+		pageOnePath = "title/tt15398776/reviews"
+		pageTwoPath = "title/tt15398776/reviews/_ajax"
+	)
+
+	tests := []struct {
+		name        string
+		firstPage   string
+		ctx         context.Context
+		wantTitles  []string
+		wantCount   int
+		wantScrapes int
+		wantErr     error
+	}{
+		{
+			name:      "returns error when scraped review is invalid",
+			firstPage: "invalid_review_rating.html",
+			ctx:       context.Background(),
+			wantErr:   yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:        "returns partial results when scraped review is invalid in lenient mode",
+			firstPage:   "invalid_review_rating.html",
+			ctx:         yts.WithScrapeMode(context.Background(), yts.ScrapeModeLenient),
+			wantTitles:  []string{"title-one", "title-two", "title-three", "title-five", "title-six"},
+			wantCount:   6,
+			wantScrapes: 1,
+		},
+		{
+			name:      "returns de-duplicated reviews of movie page and every review page",
+			firstPage: "reviews_page_1.html",
+			ctx:       context.Background(),
+			wantTitles: []string{
+				"title-one", "title-two", "title-three", "title-four", "title-five", "title-six",
+			},
+			wantCount: 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := createTestServer(
+				t,
+				defaultHandlerConfig(t, path.Join("movies", movieSlug), testdataDir, "movie_page.html"),
+				defaultHandlerConfig(t, pageOnePath, testdataDir, tt.firstPage),
+				defaultHandlerConfig(t, pageTwoPath, testdataDir, "reviews_page_2.html"),
+			)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			clientCfg := yts.DefaultClientConfig()
+			clientCfg.SiteURL = *serverURL
+			clientCfg.Transport = serverRoundTripper(t, server)
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			got, err := c.AllMovieReviewsWithContext(tt.ctx, movieSlug)
+			assertError(t, methodName, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+
+			titles := make([]string, 0)
+			for _, review := range got.Data.Reviews {
+				titles = append(titles, review.Title)
+			}
+
+			assertEqual(t, methodName, titles, tt.wantTitles)
+			assertEqual(t, methodName, got.Data.ReviewCount, tt.wantCount)
+			assertEqual(t, methodName, got.Data.ReviewsMore, false)
+			assertEqual(t, methodName, len(got.ScrapeErrors), tt.wantScrapes)
+		})
+	}
+}
+
+func TestClient_AllMovieReviewsMaxReviewsPages(t *testing.T) {
+	const (
+		methodName  = "Client.AllMovieReviews"
+		testdataDir = "movie_reviews_pages"
+		movieSlug   = "oppenheimer-2023"
+	)
+
+	// Every page of the endless listing links to a page nested below it, the
+	// listing being served for every path below the title.
+	counter := &testRequestCounter{}
+	listingCfg := defaultHandlerConfig(t, "title/tt15398776", testdataDir, "endless_reviews_page.html")
+	listingCfg.pattern += "/"
+
+	server := createTestServer(
+		t,
+		defaultHandlerConfig(t, path.Join("movies", movieSlug), testdataDir, "movie_page.html"),
+		listingCfg.withCounter(counter),
+	)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	clientCfg := yts.DefaultClientConfig()
+	clientCfg.SiteURL = *serverURL
+	clientCfg.Transport = serverRoundTripper(t, server)
+	clientCfg.OffSiteRateLimit = yts.RateLimit{}
+
+	c, _ := yts.NewClientWithConfig(&clientCfg)
+	got, err := c.AllMovieReviewsWithContext(context.Background(), movieSlug)
+	assertError(t, methodName, err, nil)
+	if err != nil {
+		return
+	}
+
+	assertEqual(t, methodName, got.Data.ReviewsMore, true)
+	assertEqual(t, methodName, counter.requests.Load(), int32(yts.MaxReviewsPages))
+}
+
 func TestClient_MovieCommentsWithContext(t *testing.T) {
 	const (
 		methodName           = "Client.MovieComments"
@@ -1156,25 +2069,34 @@ func TestClient_MovieCommentsWithContext(t *testing.T) {
 				CommentsMore: more,
 				Comments: []yts.SiteMovieComment{
 					{
-						Author:    "aaron2023",
-						AvatarURL: "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
-						Timestamp: "April 30, 2024 at 09:46 am",
-						Content:   "content-one",
-						LikeCount: 0,
+						ID:                35774453,
+						Author:            "aaron2023",
+						AvatarURL:         "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
+						Timestamp:         "April 30, 2024 at 09:46 am",
+						Content:           "content-one",
+						LikeCount:         0,
+						PostedAt:          time.Date(2024, time.April, 30, 9, 46, 0, 0, time.UTC),
+						PostedAtPrecision: yts.TimePrecisionMinute,
 					},
 					{
-						Author:    "AmanS666",
-						AvatarURL: "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
-						Timestamp: "January 29, 2024 at 09:13 am",
-						Content:   "content-two",
-						LikeCount: 1,
+						ID:                35757878,
+						Author:            "AmanS666",
+						AvatarURL:         "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
+						Timestamp:         "January 29, 2024 at 09:13 am",
+						Content:           "content-two",
+						LikeCount:         1,
+						PostedAt:          time.Date(2024, time.January, 29, 9, 13, 0, 0, time.UTC),
+						PostedAtPrecision: yts.TimePrecisionMinute,
 					},
 					{
-						Author:    "zorg2",
-						AvatarURL: "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
-						Timestamp: "January 19, 2024 at 10:44 am",
-						Content:   "content-three",
-						LikeCount: 0,
+						ID:                35755966,
+						Author:            "zorg2",
+						AvatarURL:         "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
+						Timestamp:         "January 19, 2024 at 10:44 am",
+						Content:           "content-three",
+						LikeCount:         0,
+						PostedAt:          time.Date(2024, time.January, 19, 10, 44, 0, 0, time.UTC),
+						PostedAtPrecision: yts.TimePrecisionMinute,
 					},
 				},
 			},
@@ -1186,9 +2108,56 @@ func TestClient_MovieCommentsWithContext(t *testing.T) {
 		mockedOKResponse     = getMockedResponse(false)
 	)
 
-	tests := []struct {
-		name         string
-		handlerCfgs  []testHTTPHandlerConfig
+	newMockedComment := func(id, parentID int, author, content string, postedAt time.Time) yts.SiteMovieComment {
+		return yts.SiteMovieComment{
+			ID:                id,
+			ParentID:          parentID,
+			Author:            author,
+			AvatarURL:         "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
+			Timestamp:         postedAt.Format("January 2, 2006 at 03:04 pm"),
+			Content:           content,
+			PostedAt:          postedAt,
+			PostedAtPrecision: yts.TimePrecisionMinute,
+		}
+	}
+
+	var (
+		replyOneOne = newMockedComment(
+			35774471, 35774460, "zorg2", "reply-one-one", time.Date(2024, time.April, 30, 11, 15, 0, 0, time.UTC),
+		)
+		replyOne = newMockedComment(
+			35774460, 35774453, "AmanS666", "reply-one", time.Date(2024, time.April, 30, 10, 2, 0, 0, time.UTC),
+		)
+		replyTwo = newMockedComment(
+			35774482, 35774453, "zorg2", "reply-two", time.Date(2024, time.May, 1, 8, 30, 0, 0, time.UTC),
+		)
+		commentOne = newMockedComment(
+			35774453, 0, "aaron2023", "content-one", time.Date(2024, time.April, 30, 9, 46, 0, 0, time.UTC),
+		)
+		commentTwo = newMockedComment(
+			35757878, 0, "AmanS666", "content-two", time.Date(2024, time.January, 29, 9, 13, 0, 0, time.UTC),
+		)
+	)
+
+	replyOne.LikeCount = 1
+	replyOne.Replies = []yts.SiteMovieComment{replyOneOne}
+	replyOne.ReplyCount = 1
+	commentOne.LikeCount = 2
+	commentOne.Replies = []yts.SiteMovieComment{replyOne, replyTwo}
+	commentOne.ReplyCount = 2
+	commentTwo.LikeCount = 1
+	commentTwo.ReplyCount = 4
+
+	mockedNestedResponse := &yts.MovieCommentsResponse{
+		Data: yts.MovieCommentsData{
+			CommentsMore: false,
+			Comments:     []yts.SiteMovieComment{commentOne, commentTwo},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		handlerCfgs  []testHTTPHandlerConfig
 		clientCfg    yts.ClientConfig
 		ctx          context.Context
 		movieSlug    string
@@ -1293,6 +2262,24 @@ func TestClient_MovieCommentsWithContext(t *testing.T) {
 			commentsPage: 1,
 			wantErr:      yts.ErrContentRetrievalFailure,
 		},
+		{
+			name:         "returns error when any reply to a movie comment is invalid",
+			handlerCfgs:  getHandlerCfgsFor("invalid_reply"),
+			clientCfg:    yts.DefaultClientConfig(),
+			ctx:          context.Background(),
+			movieSlug:    movieSlug,
+			commentsPage: 1,
+			wantErr:      yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:         "returns threaded replies when movie comments are nested",
+			handlerCfgs:  getHandlerCfgsFor("nested_replies"),
+			clientCfg:    yts.DefaultClientConfig(),
+			ctx:          context.Background(),
+			movieSlug:    movieSlug,
+			commentsPage: 1,
+			want:         mockedNestedResponse,
+		},
 		{
 			name:         "returns error when request context times out",
 			handlerCfgs:  getHandlerCfgsFor("ok_response"),
@@ -1340,6 +2327,135 @@ func TestClient_MovieCommentsWithContext(t *testing.T) {
 	}
 }
 
+var mockedSimilarMovies = []yts.SiteSimilarMovie{
+	{
+		SiteMovieBase: yts.SiteMovieBase{
+			Title:  "The Imitation Game",
+			Year:   2014,
+			Link:   "https://yts.mx/movies/the-imitation-game-2014",
+			Image:  "/assets/images/movies/The_Imitation_Game_2014/medium-cover.jpg",
+			Genres: []yts.Genre{},
+		},
+		Slug: "the-imitation-game-2014",
+	},
+	{
+		SiteMovieBase: yts.SiteMovieBase{
+			Title:  "Dunkirk",
+			Year:   2017,
+			Link:   "https://yts.mx/movies/dunkirk-2017",
+			Image:  "/assets/images/movies/dunkirk_2017/medium-cover.jpg",
+			Genres: []yts.Genre{},
+		},
+		Slug: "dunkirk-2017",
+	},
+}
+
+func TestClient_SimilarMoviesWithContext(t *testing.T) {
+	const (
+		methodName  = "Client.SimilarMovies"
+		testdataDir = "similar_movies"
+		movieSlug   = "oppenheimer-2023"
+		pattern     = "/movies/oppenheimer-2023"
+	)
+
+	timedoutCtx, cancel := context.WithDeadline(
+		context.Background(), time.Now(),
+	)
+	defer cancel()
+
+	mockedOKResponse := &yts.SimilarMoviesResponse{
+		Data: yts.SimilarMoviesData{
+			Movies: mockedSimilarMovies,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		handlerCfg testHTTPHandlerConfig
+		clientCfg  yts.ClientConfig
+		ctx        context.Context
+		movieSlug  string
+		want       *yts.SimilarMoviesResponse
+		wantErr    error
+	}{
+		{
+			name:      "returns error when movie slug is an empty string",
+			clientCfg: yts.DefaultClientConfig(),
+			ctx:       context.Background(),
+			movieSlug: "",
+			wantErr:   yts.ErrValidationFailure,
+		},
+		{
+			name:       "returns no similar movies when similar movies block is missing",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "missing_similar_movies.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			want: &yts.SimilarMoviesResponse{
+				Data: yts.SimilarMoviesData{Movies: []yts.SiteSimilarMovie{}},
+			},
+		},
+		{
+			name:       "returns error when link is not a movie page link",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "invalid_link.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when image is missing",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "missing_image.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when year is missing",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "missing_year.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when request context times out",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        timedoutCtx,
+			movieSlug:  movieSlug,
+			wantErr:    context.DeadlineExceeded,
+		},
+		{
+			name:       "returns similar movies scraped from movie page",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			want:       mockedOKResponse,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientCfg := tt.clientCfg
+			if tt.handlerCfg.pattern != "" {
+				server := createTestServer(t, tt.handlerCfg)
+				serverURL, _ := url.Parse(server.URL)
+				clientCfg.SiteURL = *serverURL
+				defer server.Close()
+			}
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			got, err := c.SimilarMoviesWithContext(tt.ctx, tt.movieSlug)
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, got, tt.want)
+		})
+	}
+}
+
 func TestClient_MovieAdditionalDetailsWithContext(t *testing.T) {
 	const (
 		methodName       = "Client.MovieAdditionalDetails"
@@ -1365,25 +2481,34 @@ func TestClient_MovieAdditionalDetailsWithContext(t *testing.T) {
 			},
 			Comments: []yts.SiteMovieComment{
 				{
-					Author:    "aaron2023",
-					AvatarURL: "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
-					Timestamp: "April 30, 2024 at 09:46 am",
-					Content:   "content-one",
-					LikeCount: 0,
+					ID:                35774453,
+					Author:            "aaron2023",
+					AvatarURL:         "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
+					Timestamp:         "April 30, 2024 at 09:46 am",
+					Content:           "content-one",
+					LikeCount:         0,
+					PostedAt:          time.Date(2024, time.April, 30, 9, 46, 0, 0, time.UTC),
+					PostedAtPrecision: yts.TimePrecisionMinute,
 				},
 				{
-					Author:    "AmanS666",
-					AvatarURL: "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
-					Timestamp: "January 29, 2024 at 09:13 am",
-					Content:   "content-two",
-					LikeCount: 1,
+					ID:                35757878,
+					Author:            "AmanS666",
+					AvatarURL:         "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
+					Timestamp:         "January 29, 2024 at 09:13 am",
+					Content:           "content-two",
+					LikeCount:         1,
+					PostedAt:          time.Date(2024, time.January, 29, 9, 13, 0, 0, time.UTC),
+					PostedAtPrecision: yts.TimePrecisionMinute,
 				},
 				{
-					Author:    "zorg2",
-					AvatarURL: "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
-					Timestamp: "January 19, 2024 at 10:44 am",
-					Content:   "content-three",
-					LikeCount: 0,
+					ID:                35755966,
+					Author:            "zorg2",
+					AvatarURL:         "https://img.yts.mx/assets/images/users/thumb/default_avatar.jpg",
+					Timestamp:         "January 19, 2024 at 10:44 am",
+					Content:           "content-three",
+					LikeCount:         0,
+					PostedAt:          time.Date(2024, time.January, 19, 10, 44, 0, 0, time.UTC),
+					PostedAtPrecision: yts.TimePrecisionMinute,
 				},
 			},
 			Reviews: []yts.SiteMovieReview{
@@ -1407,9 +2532,13 @@ func TestClient_MovieAdditionalDetailsWithContext(t *testing.T) {
 				},
 			},
 			ReviewsMoreLink: "https://www.imdb.com/title/tt15398776/reviews",
+			SimilarMovies:   []yts.SiteSimilarMovie{},
 		},
 	}
 
+	mockedSimilarResponse := mockedOKResponse
+	mockedSimilarResponse.Data.SimilarMovies = mockedSimilarMovies
+
 	timedoutCtx, cancel := context.WithDeadline(
 		context.Background(), time.Now(),
 	)
@@ -1471,6 +2600,14 @@ func TestClient_MovieAdditionalDetailsWithContext(t *testing.T) {
 			movieSlug:   movieSlug,
 			want:        &mockedOKResponse,
 		},
+		{
+			name:        "returns similar movies when movie page shows them",
+			handlerCfgs: getHandlerCfgsFor("similar_movies"),
+			clientCfg:   yts.DefaultClientConfig(),
+			ctx:         context.Background(),
+			movieSlug:   movieSlug,
+			want:        &mockedSimilarResponse,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1491,6 +2628,199 @@ func TestClient_MovieAdditionalDetailsWithContext(t *testing.T) {
 	}
 }
 
+func TestClient_MovieSiteDetailsWithContext(t *testing.T) {
+	const (
+		methodName  = "Client.MovieSiteDetails"
+		testdataDir = "movie_site_details"
+		movieSlug   = "oppenheimer-2023"
+		pattern     = "/movies/oppenheimer-2023"
+	)
+
+	timedoutCtx, cancel := context.WithDeadline(
+		context.Background(), time.Now(),
+	)
+	defer cancel()
+
+	mockedOKResponse := &yts.MovieSiteDetailsResponse{
+		Data: yts.MovieSiteDetailsData{
+			Details: yts.SiteMovieDetails{
+				Title:         "Oppenheimer",
+				Year:          2023,
+				Genres:        []yts.Genre{yts.GenreBiography, yts.GenreDrama, yts.GenreHistory},
+				Synopsis:      "The story of J. Robert Oppenheimer's role in the development of the atomic bomb during World War II.",
+				Likes:         1161,
+				IMDbRating:    8.3,
+				IMDbLink:      "https://www.imdb.com/title/tt15398776/",
+				CriticsScore:  "93%",
+				AudienceScore: "91%",
+				Cast: []yts.SiteMovieCastMember{
+					{
+						Name:          "Cillian Murphy",
+						CharacterName: "J. Robert Oppenheimer",
+						URLSmallImage: "https://img.yts.mx/assets/images/actors/thumb/nm0614165.jpg",
+					},
+					{
+						Name:          "Matt Damon",
+						CharacterName: "Leslie Groves",
+						URLSmallImage: "https://img.yts.mx/assets/images/actors/thumb/nm0000354.jpg",
+					},
+				},
+				TechSpecs: []yts.SiteMovieTechSpecs{
+					{
+						Quality:      yts.Quality720p,
+						Type:         "bluray",
+						FileSize:     "1.72 GB",
+						Resolution:   "1280*536",
+						Language:     "English 2.0",
+						MPARating:    "R",
+						SubtitlesURL: "https://yifysubtitles.ch/movie-imdb/tt15398776",
+						FrameRate:    23.976,
+						Runtime:      180,
+						Peers:        43,
+						Seeds:        529,
+					},
+					{
+						Quality:    yts.Quality1080p,
+						Type:       "web",
+						FileSize:   "3.6 GB",
+						Resolution: "1920*800",
+						Language:   "English 5.1",
+						MPARating:  "R",
+						FrameRate:  23.976,
+						Runtime:    180,
+						Peers:      12,
+						Seeds:      218,
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		handlerCfg testHTTPHandlerConfig
+		clientCfg  yts.ClientConfig
+		ctx        context.Context
+		movieSlug  string
+		want       *yts.MovieSiteDetailsResponse
+		wantErr    error
+	}{
+		{
+			name:      "returns error when movie slug is an empty string",
+			clientCfg: yts.DefaultClientConfig(),
+			ctx:       context.Background(),
+			movieSlug: "",
+			wantErr:   yts.ErrValidationFailure,
+		},
+		{
+			name:       "returns error when movie info selector is missing",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "missing_movie_info.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when title is missing",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "missing_title.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when synopsis is missing",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "missing_synopsis.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when genres are invalid",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "invalid_genres.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when IMDb rating is invalid",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "invalid_imdb_rating.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when cast member name is missing",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "missing_cast_name.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when tech specs quality is invalid",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "invalid_tech_specs_quality.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when peers and seeds are invalid",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "invalid_peers_and_seeds.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrContentRetrievalFailure,
+		},
+		{
+			name:       "returns error when request context times out",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        timedoutCtx,
+			movieSlug:  movieSlug,
+			wantErr:    context.DeadlineExceeded,
+		},
+		{
+			name:       "returns error when response status is outside 2.x.x range",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "non_existent.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			wantErr:    yts.ErrUnexpectedHTTPResponseStatus,
+		},
+		{
+			name:       "returns movie site details scraped from movie page",
+			handlerCfg: defaultHandlerConfig(t, pattern, testdataDir, "ok_response.html"),
+			clientCfg:  yts.DefaultClientConfig(),
+			ctx:        context.Background(),
+			movieSlug:  movieSlug,
+			want:       mockedOKResponse,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientCfg := tt.clientCfg
+			if tt.handlerCfg.pattern != "" {
+				server := createTestServer(t, tt.handlerCfg)
+				serverURL, _ := url.Parse(server.URL)
+				clientCfg.SiteURL = *serverURL
+				defer server.Close()
+			}
+
+			c, _ := yts.NewClientWithConfig(&clientCfg)
+			got, err := c.MovieSiteDetailsWithContext(tt.ctx, tt.movieSlug)
+			assertError(t, methodName, err, tt.wantErr)
+			assertEqual(t, methodName, got, tt.want)
+		})
+	}
+}
+
 func TestClient_MagnetLinks(t *testing.T) {
 	var (
 		config    = yts.DefaultClientConfig()
@@ -1537,3 +2867,57 @@ func TestClient_MagnetLinks(t *testing.T) {
 	got := client.MagnetLinks(&infoGetter)
 	assertEqual(t, "Client.MagnetLinks", got, want)
 }
+
+func TestFlattenComments(t *testing.T) {
+	const funcName = "FlattenComments"
+
+	var (
+		replyOneOne = yts.SiteMovieComment{ID: 3, ParentID: 2, Author: "reply-one-one"}
+		replyOne    = yts.SiteMovieComment{
+			ID:         2,
+			ParentID:   1,
+			Author:     "reply-one",
+			Replies:    []yts.SiteMovieComment{replyOneOne},
+			ReplyCount: 1,
+		}
+		replyTwo   = yts.SiteMovieComment{ID: 4, ParentID: 1, Author: "reply-two"}
+		commentOne = yts.SiteMovieComment{
+			ID:         1,
+			Author:     "comment-one",
+			Replies:    []yts.SiteMovieComment{replyOne, replyTwo},
+			ReplyCount: 2,
+		}
+		commentTwo = yts.SiteMovieComment{ID: 5, Author: "comment-two", ReplyCount: 4}
+	)
+
+	flatReplyOne := replyOne
+	flatReplyOne.Replies = nil
+	flatCommentOne := commentOne
+	flatCommentOne.Replies = nil
+
+	tests := []struct {
+		name     string
+		comments []yts.SiteMovieComment
+		want     []yts.SiteMovieComment
+	}{
+		{
+			name:     "returns empty list when no comments are provided",
+			comments: nil,
+			want:     []yts.SiteMovieComment{},
+		},
+		{
+			name:     "returns comments followed by their replies in thread order",
+			comments: []yts.SiteMovieComment{commentOne, commentTwo},
+			want: []yts.SiteMovieComment{
+				flatCommentOne, flatReplyOne, replyOneOne, replyTwo, commentTwo,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := yts.FlattenComments(tt.comments)
+			assertEqual(t, funcName, got, tt.want)
+		})
+	}
+}